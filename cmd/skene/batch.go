@@ -0,0 +1,249 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"skene/internal/constants"
+	"skene/internal/services/config"
+	"skene/internal/services/gitclone"
+	"skene/internal/services/growth"
+	"skene/internal/services/history"
+	"skene/internal/tui/views"
+)
+
+// batchResult is one row of the --batch summary
+type batchResult struct {
+	project  string
+	output   string
+	duration time.Duration
+	err      error
+
+	// Recorded in the history once every analysis has finished
+	entry *history.Entry
+}
+
+// runBatch analyzes every project in spec without the TUI, at most
+// concurrency at a time, and prints a summary table to stdout. spec is a
+// comma-separated list of directories or git URLs, or @path to read them
+// from a file with one per line. Each project gets its own output
+// directory. Returns 1 when any analysis failed.
+func runBatch(spec string, concurrency int, offline bool, creds credentials) int {
+	if concurrency < 1 || concurrency > constants.MaxBatchConcurrency {
+		fmt.Fprintf(os.Stderr, "Error: --batch-concurrency must be between 1 and %d\n", constants.MaxBatchConcurrency)
+		return 1
+	}
+	projects, err := parseBatchSpec(spec)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	configMgr, err := loadHeadlessConfig("--batch", "", offline, creds)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	if err := checkBatchOutputs(configMgr.Config, projects); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	// Cancelling the context kills every running git or uvx subprocess
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	results := make([]batchResult, len(projects))
+	slots := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, project := range projects {
+		// Taking the slot here starts the projects in the listed order
+		slots <- struct{}{}
+		if ctx.Err() != nil {
+			results[i] = batchResult{project: project, err: ctx.Err()}
+			<-slots
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-slots }()
+			results[i] = analyzeBatchProject(ctx, configMgr, project)
+		}()
+	}
+	wg.Wait()
+
+	// The history file is rewritten on every record, so not concurrently
+	for _, r := range results {
+		if r.entry != nil {
+			history.Record(*r.entry)
+		}
+	}
+
+	printBatchSummary(os.Stdout, results)
+	for _, r := range results {
+		if r.err != nil {
+			return 1
+		}
+	}
+	return 0
+}
+
+// parseBatchSpec returns the projects listed in a --batch value, skipping
+// blank entries and, in a file, lines starting with #
+func parseBatchSpec(spec string) ([]string, error) {
+	var entries []string
+	if strings.HasPrefix(spec, "@") {
+		data, err := os.ReadFile(strings.TrimPrefix(spec, "@"))
+		if err != nil {
+			return nil, fmt.Errorf("could not read batch list: %w", err)
+		}
+		for _, line := range strings.Split(string(data), "\n") {
+			if line = strings.TrimSpace(line); !strings.HasPrefix(line, "#") {
+				entries = append(entries, line)
+			}
+		}
+	} else {
+		entries = strings.Split(spec, ",")
+	}
+
+	var projects []string
+	for _, entry := range entries {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			projects = append(projects, entry)
+		}
+	}
+	if len(projects) == 0 {
+		return nil, fmt.Errorf("--batch lists no projects")
+	}
+	return projects, nil
+}
+
+// analyzeBatchProject runs one analysis of a --batch run. A git URL is
+// cloned first and the clone removed afterwards.
+func analyzeBatchProject(ctx context.Context, configMgr *config.Manager, project string) batchResult {
+	start := time.Now()
+	result := batchResult{project: project}
+	finish := func(err error) batchResult {
+		result.err = err
+		result.duration = time.Since(start)
+		return result
+	}
+
+	cfg := configMgr.Config
+	output, err := batchOutputDir(cfg, project)
+	if err != nil {
+		return finish(err)
+	}
+	result.output = output
+	var projectDir string
+	if gitclone.IsRemoteURL(project) {
+		dir, err := cloneHeadless(ctx, project, cfg.Offline)
+		if err != nil {
+			return finish(err)
+		}
+		defer gitclone.Remove(dir)
+		projectDir = dir
+	} else {
+		projectDir, _ = filepath.Abs(project)
+		if err := views.ValidateProjectDir(projectDir); err != nil {
+			return finish(err)
+		}
+	}
+
+	engineCfg := headlessEngineConfig(configMgr, projectDir, result.output)
+	if err := growth.CheckOutputWritable(result.output); err != nil {
+		return finish(err)
+	}
+
+	logger := newPlainLogger(os.Stderr, engineCfg)
+	logger.prefix = batchName(project) + ": "
+	engine := growth.NewEngine(engineCfg, logger.Update)
+	engine.SetPromptHandler(logger.answerFirst)
+
+	if run := engine.Run(ctx); run.Error != nil {
+		return finish(run.Error)
+	}
+	if !gitclone.IsRemoteURL(project) {
+		project = projectDir
+	}
+	result.entry = &history.Entry{ProjectDir: project, OutputDir: result.output, Provider: cfg.Provider, Model: cfg.Model, OutputFiles: cfg.OutputFiles}
+	return finish(nil)
+}
+
+// batchOutputDir returns where a --batch project's results go: under a
+// local project, or skene-context/<repo> in the working directory for a URL
+func batchOutputDir(cfg *config.Config, project string) (string, error) {
+	if gitclone.IsRemoteURL(project) {
+		return gitclone.OutputDir(cfg.OutputDir, project), nil
+	}
+	abs, err := filepath.Abs(project)
+	if err != nil {
+		return "", err
+	}
+	return localOutputDir(cfg, abs), nil
+}
+
+// checkBatchOutputs refuses a batch in which two projects would write to
+// the same output directory, such as org1/api and org2/api, or any two
+// projects with an absolute output_dir
+func checkBatchOutputs(cfg *config.Config, projects []string) error {
+	owner := map[string]string{}
+	for _, project := range projects {
+		output, err := batchOutputDir(cfg, project)
+		if err != nil {
+			return err
+		}
+		if other, ok := owner[output]; ok {
+			return fmt.Errorf("%s and %s would both write to %s; analyze them in separate batches", other, project, output)
+		}
+		owner[output] = project
+	}
+	return nil
+}
+
+// batchName is the short project name used in log lines
+func batchName(project string) string {
+	name := filepath.Base(strings.TrimSuffix(strings.TrimRight(project, "/"), ".git"))
+	if i := strings.LastIndexAny(name, ":/"); i >= 0 {
+		name = name[i+1:]
+	}
+	return name
+}
+
+// printBatchSummary writes one row per project, then the error of each
+// failed one
+func printBatchSummary(w io.Writer, results []batchResult) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "PROJECT\tSTATUS\tDURATION\tOUTPUT")
+	failed := 0
+	for _, r := range results {
+		status := "ok"
+		if r.err != nil {
+			status = "failed"
+			failed++
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", r.project, status, r.duration.Round(time.Second), r.output)
+	}
+	tw.Flush()
+
+	if failed == 0 {
+		fmt.Fprintf(w, "\n%d of %d analyses succeeded\n", len(results), len(results))
+		return
+	}
+	fmt.Fprintf(w, "\n%d of %d analyses failed:\n", failed, len(results))
+	for _, r := range results {
+		if r.err != nil {
+			// Keep multi-line process output under its project
+			fmt.Fprintf(w, "  %s: %s\n", r.project, strings.ReplaceAll(strings.TrimSpace(r.err.Error()), "\n", "\n    "))
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"skene/internal/services/config"
+)
+
+// runCheckConfig validates a config file without starting the TUI and
+// prints a pass/fail report. path defaults to the project config
+// (.skene.config, .skene.yaml or .skene.yml) in the current directory. Returns the process exit code.
+func runCheckConfig(path string) int {
+	if path == "" {
+		path = config.ProjectConfigIn(".")
+	}
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		abs = path
+	}
+	fmt.Printf("Checking %s\n", abs)
+
+	configMgr := config.NewManager(filepath.Dir(abs))
+	if err := configMgr.LoadFile(abs); err != nil {
+		fmt.Printf("  ✗ %v\n", err)
+		fmt.Println("FAIL")
+		return 1
+	}
+
+	errs := configMgr.Validate()
+	if len(errs) == 0 {
+		cfg := configMgr.Config
+		fmt.Printf("  ✓ provider %s, model %s\n", cfg.Provider, cfg.Model)
+		fmt.Println("PASS")
+		return 0
+	}
+
+	for _, err := range errs {
+		fmt.Printf("  ✗ %v\n", err)
+	}
+	if len(errs) == 1 {
+		fmt.Println("FAIL: 1 problem")
+	} else {
+		fmt.Printf("FAIL: %d problems\n", len(errs))
+	}
+	return 1
+}
@@ -0,0 +1,200 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"skene/internal/constants"
+	"skene/internal/services/config"
+	"skene/internal/services/gitclone"
+	"skene/internal/services/growth"
+	"skene/internal/services/history"
+	"skene/internal/services/httpclient"
+	"skene/internal/services/syscheck"
+)
+
+// runHeadlessJSON runs the analysis without the TUI using the saved config
+// and prints the AnalysisJSON document to stdout. Progress goes to stderr so
+// stdout stays machine-readable. projectDir overrides the configured
+// project when set, remote names a git repository to clone and analyze
+// instead, creds override the configured provider, model and key,
+// and offline forces offline mode. Returns the process exit code.
+func runHeadlessJSON(projectDir string, remote remoteRepo, offline bool, creds credentials) int {
+	configMgr, err := loadHeadlessConfig("--json", projectDir, offline, creds)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	cfg := configMgr.Config
+
+	// Cancelling the context kills the git or uvx subprocess
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if remote.url != "" {
+		dir, err := cloneHeadless(ctx, remote.url, cfg.Offline)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+		if remote.keep {
+			defer fmt.Fprintf(os.Stderr, constants.CloneKept+"\n", dir)
+		} else {
+			defer gitclone.Remove(dir)
+		}
+		projectDir = dir
+	}
+
+	if projectDir == "" {
+		projectDir = cfg.ProjectDir
+	}
+	if projectDir == "" {
+		projectDir, _ = os.Getwd()
+	}
+	var outputDir string
+	if remote.url != "" {
+		outputDir = gitclone.OutputDir(cfg.OutputDir, remote.url)
+	} else {
+		outputDir = localOutputDir(cfg, projectDir)
+	}
+	engineCfg := headlessEngineConfig(configMgr, projectDir, outputDir)
+
+	if err := growth.CheckOutputWritable(outputDir); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	logger := newPlainLogger(os.Stderr, engineCfg)
+	engine := growth.NewEngine(engineCfg, logger.Update)
+	// Nobody is around to answer prompts, so take the first option
+	engine.SetPromptHandler(logger.answerFirst)
+
+	result := engine.Run(ctx)
+	if result.Error != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", result.Error)
+		return 1
+	}
+
+	project := projectDir
+	if remote.url != "" {
+		project = remote.url
+	}
+	history.Record(history.Entry{ProjectDir: project, OutputDir: outputDir, Provider: cfg.Provider, Model: cfg.Model, OutputFiles: cfg.OutputFiles})
+
+	data, err := json.MarshalIndent(result.JSON, "", "  ")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	fmt.Println(string(data))
+	return 0
+}
+
+// loadHeadlessConfig loads the saved config with the flag overrides applied
+// and sets up the HTTP client from it. mode names the flag in errors, and
+// projectDir, when set, is searched for the project config instead of the
+// working directory.
+func loadHeadlessConfig(mode, projectDir string, offline bool, creds credentials) (*config.Manager, error) {
+	if projectDir == "" {
+		projectDir = "."
+	}
+	configMgr := config.NewManager(projectDir)
+	configMgr.LoadConfig()
+	if creds.provider != "" {
+		configMgr.SetProvider(creds.provider)
+	}
+	if creds.model != "" {
+		configMgr.SetModel(creds.model)
+	}
+	if creds.apiKey != "" {
+		configMgr.SetAPIKey(creds.apiKey)
+	}
+	if offline {
+		configMgr.SetOffline()
+	}
+
+	cfg := configMgr.Config
+	if err := httpclient.SetProxy(cfg.Proxy); err != nil {
+		return nil, err
+	}
+	if cfg.Provider == "" || cfg.Model == "" {
+		return nil, fmt.Errorf("%s requires a provider and model in .skene.config or ~/.config/skene/config", mode)
+	}
+	if cfg.APIKey == "" && !config.IsLocalProvider(cfg.Provider) {
+		return nil, fmt.Errorf("%s requires an API key: pass --api-key or --api-key-file, or set api_key in .skene.config or ~/.config/skene/config", mode)
+	}
+	return configMgr, nil
+}
+
+// localOutputDir resolves the configured output directory against a
+// local project
+func localOutputDir(cfg *config.Config, projectDir string) string {
+	outputDir := cfg.OutputDir
+	if outputDir == "" {
+		outputDir = constants.DefaultOutputDir
+	}
+	if !filepath.IsAbs(outputDir) {
+		outputDir = filepath.Join(projectDir, outputDir)
+	}
+	return outputDir
+}
+
+// headlessEngineConfig builds the engine settings for one project from the
+// loaded config
+func headlessEngineConfig(configMgr *config.Manager, projectDir, outputDir string) growth.EngineConfig {
+	cfg := configMgr.Config
+	return growth.EngineConfig{
+		Provider:        cfg.Provider,
+		Model:           cfg.Model,
+		APIKey:          cfg.APIKey,
+		BaseURL:         cfg.BaseURL,
+		ProjectDir:      projectDir,
+		OutputDir:       outputDir,
+		UseGrowth:       cfg.UseGrowth,
+		BackupPrevious:  cfg.BackupPrevious,
+		IdleTimeout:     configMgr.IdleTimeout(),
+		AnalysisTimeout: configMgr.AnalysisTimeout(),
+		OutputFiles:     growth.OutputNames(cfg.OutputFiles),
+		MinPython:       configMgr.MinPython().String(),
+		IncrementalScan: cfg.IncrementalScan,
+		Offline:         cfg.Offline,
+		Proxy:           cfg.Proxy,
+
+		GenerateDocs:   cfg.GenerateDocs,
+		ExcludeFolders: cfg.ExcludeFolders,
+	}
+}
+
+// cloneHeadless shallow-clones url for a --json run, logging each git
+// stage to stderr once
+func cloneHeadless(ctx context.Context, url string, offline bool) (string, error) {
+	if offline {
+		return "", fmt.Errorf("offline mode is on, so %s cannot be cloned", url)
+	}
+	if check := syscheck.CheckGit(ctx); check.Status == syscheck.StatusFailed {
+		return "", fmt.Errorf("%s; git is required to analyze a repository by URL", check.Message)
+	}
+
+	logf := func(format string, args ...interface{}) {
+		fmt.Fprintf(os.Stderr, "[%s] %s\n", time.Now().Format("15:04:05"), fmt.Sprintf(format, args...))
+	}
+	logf("Cloning %s", url)
+	stage := ""
+	dir, err := gitclone.Clone(ctx, url, func(p gitclone.Progress) {
+		if p.Stage != stage {
+			stage = p.Stage
+			logf("%s", stage)
+		}
+	})
+	if err != nil {
+		return "", err
+	}
+	logf("Cloned into %s", dir)
+	return dir, nil
+}
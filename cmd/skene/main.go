@@ -1,36 +1,228 @@
 package main
 
 import (
+	"errors"
+	"flag"
 	"fmt"
 	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
 
+	"skene/internal/constants"
+	"skene/internal/services/config"
+	"skene/internal/services/gitclone"
 	"skene/internal/tui"
 	"skene/internal/tui/styles"
+	"skene/internal/tui/views"
 
 	tea "github.com/charmbracelet/bubbletea"
 )
 
+// Build metadata, injected at build time:
+//
+//	go build -ldflags "-X main.version=v1.0.0 -X main.commit=abc123 -X main.buildDate=2024-01-01"
+var (
+	version   string
+	commit    string
+	buildDate string
+)
+
 func main() {
+	showVersion := flag.Bool("version", false, "Print version and build information, then exit")
+	noUpdateCheck := flag.Bool("no-update-check", false, "Skip the background check for newer releases")
+	jsonOutput := flag.Bool("json", false, "Run the analysis headless and print results as JSON")
+	offline := flag.Bool("offline", false, "Never use the network for updates or installing uv")
+	project := flag.String("project", "", "Project directory or git URL to analyze, skipping the directory screen (or set SKENE_PROJECT)")
+	keepClone := flag.Bool("keep-clone", false, "Keep the temporary clone when --project is a git URL")
+	noAnimation := flag.Bool("no-animation", false, "Show a static welcome screen instead of the logo animation")
+	provider := flag.String("provider", "", "Provider ID to use instead of the configured one, e.g. openai")
+	model := flag.String("model", "", "Model ID to use instead of the configured one")
+	apiKey := flag.String("api-key", "", "API key to use instead of the configured one; @path reads it from a file")
+	apiKeyFile := flag.String("api-key-file", "", "Read the API key from this file, keeping it out of shell history")
+	skipSetup := flag.Bool("skip-setup", false, "Start on project selection when provider, model and key are valid")
+	yes := flag.Bool("yes", false, "Skip the Analysis Configuration screen and run with the saved settings")
+	batch := flag.String("batch", "", "Analyze several projects headless: comma-separated directories or git URLs, or @file with one per line")
+	batchConcurrency := flag.Int("batch-concurrency", 1, "How many --batch analyses run at once")
+	checkConfig := flag.Bool("check-config", false, "Validate a config file (default .skene.config) and exit; usage: --check-config [path]")
+	flag.Parse()
+
+	applyBuildInfo()
+	if *showVersion {
+		fmt.Println(constants.BuildInfo())
+		return
+	}
+	if *checkConfig {
+		os.Exit(runCheckConfig(flag.Arg(0)))
+	}
+
+	projectDir, remoteURL, err := resolveProjectFlag(*project)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	key, err := resolveAPIKeyFlags(*apiKey, *apiKeyFile)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	*apiKey = key
+
+	if *batch != "" {
+		if projectDir != "" || remoteURL != "" {
+			fmt.Fprintln(os.Stderr, "Error: use either --batch or --project, not both")
+			os.Exit(1)
+		}
+		os.Exit(runBatch(*batch, *batchConcurrency, *offline, credentials{*provider, *model, *apiKey}))
+	}
+
+	if *jsonOutput {
+		os.Exit(runHeadlessJSON(projectDir, remoteRepo{remoteURL, *keepClone}, *offline, credentials{*provider, *model, *apiKey}))
+	}
+
 	// Detect terminal background (light vs dark) and apply the
 	// appropriate color theme. Must run before bubbletea takes over.
 	styles.Init()
 
 	// Create the application
 	app := tui.NewApp()
+	if projectDir != "" {
+		app.SetProjectDir(projectDir)
+	}
+	if *noUpdateCheck {
+		app.DisableUpdateCheck()
+	}
+	if *noAnimation {
+		app.DisableAnimation()
+	}
+	app.SetCredentials(*provider, *model, *apiKey)
+	if *skipSetup {
+		app.EnableSkipSetup()
+	}
+	if *yes {
+		app.EnableAutoAccept()
+	}
+	if *offline {
+		app.SetOffline()
+	}
+	if remoteURL != "" {
+		app.SetRemoteProject(remoteURL, *keepClone)
+	}
 
-	// Create the program with alt screen
+	// Create the program with alt screen. Signals are handled below so
+	// cleanup runs after the terminal is restored.
 	p := tea.NewProgram(
 		app,
 		tea.WithAltScreen(),
 		tea.WithMouseCellMotion(),
+		tea.WithoutSignalHandler(),
 	)
 
 	// Set program reference for background task communication
 	app.SetProgram(p)
 
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	received := make(chan os.Signal, 1)
+	go func() {
+		received <- <-signals
+		p.Quit()
+	}()
+
 	// Run the program
-	if _, err := p.Run(); err != nil {
+	_, err = p.Run()
+	app.Cleanup()
+	app.WaitBackground(constants.ShutdownTimeout)
+	if errors.Is(err, tea.ErrProgramPanic) {
+		// A background command panicked; Bubble Tea has already restored
+		// the terminal and printed the stack trace
+		app.RecordCrash(constants.CrashBackgroundPanic, nil)
+	}
+	if kept := app.RemoveClone(); kept != "" {
+		fmt.Printf(constants.CloneKept+"\n", kept)
+	}
+	if msg := app.CrashMessage(); msg != "" {
+		fmt.Fprintln(os.Stderr, msg)
+		os.Exit(1)
+	}
+	if err != nil {
 		fmt.Printf("Error running program: %v\n", err)
 		os.Exit(1)
 	}
+	select {
+	case sig := <-received:
+		os.Exit(signalExitCode(sig))
+	default:
+	}
+}
+
+// remoteRepo is a --project git URL; keep is set by --keep-clone
+type remoteRepo struct {
+	url  string
+	keep bool
+}
+
+// credentials are the --provider, --model and --api-key overrides; empty
+// fields keep the configured value
+type credentials struct {
+	provider, model, apiKey string
+}
+
+// resolveAPIKeyFlags returns the key from --api-key-file, or --api-key with
+// any "@path" reference read from disk
+func resolveAPIKeyFlags(apiKey, apiKeyFile string) (string, error) {
+	if apiKeyFile != "" {
+		if apiKey != "" {
+			return "", fmt.Errorf("use either --api-key or --api-key-file, not both")
+		}
+		return config.ReadAPIKeyFile(apiKeyFile)
+	}
+	return config.ResolveAPIKey(apiKey)
+}
+
+// signalExitCode follows the shell convention of 128 + signal number
+func signalExitCode(sig os.Signal) int {
+	if s, ok := sig.(syscall.Signal); ok {
+		return 128 + int(s)
+	}
+	return 1
+}
+
+// applyBuildInfo copies any ldflag-injected values over the compiled-in defaults
+func applyBuildInfo() {
+	if version != "" {
+		constants.Version = version
+	}
+	if commit != "" {
+		constants.Commit = commit
+	}
+	if buildDate != "" {
+		constants.BuildDate = buildDate
+	}
+}
+
+// resolveProjectFlag returns the absolute project directory from --project,
+// falling back to SKENE_PROJECT, or the URL when it names a git repository.
+// Both are "" when neither is set.
+func resolveProjectFlag(flagValue string) (dir, url string, err error) {
+	path := flagValue
+	if path == "" {
+		path = os.Getenv("SKENE_PROJECT")
+	}
+	if path == "" {
+		return "", "", nil
+	}
+	if gitclone.IsRemoteURL(path) {
+		return "", path, nil
+	}
+
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid project path %q: %w", path, err)
+	}
+	if err := views.ValidateProjectDir(abs); err != nil {
+		return "", "", fmt.Errorf("%s: %s", abs, err)
+	}
+	return abs, "", nil
 }
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	"skene/internal/services/growth"
+)
+
+var ansiPattern = regexp.MustCompile(`\x1b\[[0-9;?]*[A-Za-z]`)
+
+// plainLogger prints engine updates as timestamped lines with no colour or
+// cursor movement, so headless runs read cleanly in CI logs:
+//
+//	[12:03:04] Phase 3/6: Growth loop analysis...
+//	[12:03:41] Phase 3/6: Growth loop analysis... done
+type plainLogger struct {
+	w       io.Writer
+	phases  []string
+	final   growth.AnalysisPhase
+	current growth.AnalysisPhase
+	started bool
+	done    bool // final phase finished; later messages print on their own
+	now     func() time.Time
+
+	// prefix names the project when several analyses share the log
+	prefix string
+}
+
+func newPlainLogger(w io.Writer, cfg growth.EngineConfig) *plainLogger {
+	return &plainLogger{
+		w:      w,
+		phases: growth.PhaseNames(cfg),
+		final:  growth.FinalPhase(cfg),
+		now:    time.Now,
+	}
+}
+
+// Update is the engine's PhaseUpdate callback
+func (l *plainLogger) Update(update growth.PhaseUpdate) {
+	if !l.done && (!l.started || update.Phase != l.current) {
+		if l.started {
+			l.printPhase(l.current, " done")
+		}
+		l.started = true
+		l.current = update.Phase
+		l.printPhase(update.Phase, "")
+	}
+
+	if msg := cleanLogLine(update.Message); msg != "" {
+		l.printf("%s", msg)
+	}
+
+	if !l.done && update.Phase == l.final && update.Progress >= 1.0 {
+		l.printPhase(update.Phase, " done")
+		l.done = true
+	}
+}
+
+func (l *plainLogger) printPhase(phase growth.AnalysisPhase, suffix string) {
+	name := phase.String()
+	for i, p := range l.phases {
+		if p == name {
+			l.printf("Phase %d/%d: %s...%s", i+1, len(l.phases), name, suffix)
+			return
+		}
+	}
+	l.printf("%s...%s", name, suffix)
+}
+
+func (l *plainLogger) printf(format string, args ...interface{}) {
+	fmt.Fprintf(l.w, "[%s] %s%s\n", l.now().Format("15:04:05"), l.prefix, fmt.Sprintf(format, args...))
+}
+
+// answerFirst is the engine's prompt handler for headless runs: nobody is
+// around to answer, so the first option is taken and logged
+func (l *plainLogger) answerFirst(prompt growth.InteractivePrompt) {
+	l.printf("%s -> %s", prompt.Question, prompt.Options[0])
+	prompt.Response <- "1"
+}
+
+// cleanLogLine strips ANSI escapes and keeps only the last carriage-return
+// segment, which is what a terminal would have shown
+func cleanLogLine(line string) string {
+	line = ansiPattern.ReplaceAllString(line, "")
+	if i := strings.LastIndex(line, "\r"); i >= 0 {
+		line = line[i+1:]
+	}
+	return strings.TrimSpace(line)
+}
@@ -1,17 +1,48 @@
 package constants
 
-// Version and repository information
+import "time"
+
+// Version and repository information. Version, Commit and BuildDate are
+// overridden at startup with the values injected via -ldflags.
 var (
 	Version    = "v0.3.0"
+	Commit     = "none"
+	BuildDate  = "unknown"
 	Repository = "github.com/SkeneTechnologies/skene-cli"
 )
 
+// BuildInfo returns a one-line description of the running build
+func BuildInfo() string {
+	return "skene " + Version + " (commit " + Commit + ", built " + BuildDate + ")"
+}
+
 // URLs
 const (
-	SkeneAuthURL       = "https://skene-cli-demo.vercel.app/auth"
-	UVDownloadBaseURL  = "https://github.com/astral-sh/uv/releases/latest/download"
-	OllamaDefaultBase  = "http://localhost:11434/v1"
+	SkeneAuthURL        = "https://skene-cli-demo.vercel.app/auth"
+	SkeneDashboardURL   = "https://www.skene.ai/dashboard"
+	UVDownloadBaseURL   = "https://github.com/astral-sh/uv/releases/latest/download"
+	OllamaDefaultBase   = "http://localhost:11434/v1"
 	LMStudioDefaultBase = "http://localhost:1234/v1"
+	UpdateCheckURL      = "https://api.github.com/repos/Px8-fi/skene-cli/releases/latest"
+
+	// Cheap endpoints probed for the provider health indicator
+	OpenAIHealthURL    = "https://api.openai.com/v1/models"
+	AnthropicHealthURL = "https://api.anthropic.com/v1/models"
+	GeminiHealthURL    = "https://generativelanguage.googleapis.com/v1beta/models"
+)
+
+// Official uv installers, offered for copying when uv cannot be downloaded
+const (
+	UVInstallCommandUnix    = "curl -LsSf https://astral.sh/uv/install.sh | sh"
+	UVInstallCommandWindows = `powershell -ExecutionPolicy ByPass -c "irm https://astral.sh/uv/install.ps1 | iex"`
+)
+
+// Model list endpoints used to fetch the latest models
+const (
+	OpenAIModelsURL     = "https://api.openai.com/v1/models"
+	AnthropicModelsURL  = "https://api.anthropic.com/v1/models?limit=100"
+	GeminiModelsURL     = "https://generativelanguage.googleapis.com/v1beta/models?pageSize=1000"
+	AnthropicAPIVersion = "2023-06-01"
 )
 
 // API key URLs for providers
@@ -22,18 +53,129 @@ const (
 	SkeneKeyURL     = "https://www.skene.ai/login"
 )
 
+// StatusLinePathMax is how much of the project path the status line shows
+const StatusLinePathMax = 40
+
+// Results dashboard contents panel: its width, and how many leading
+// characters of a heading are matched against the rendered Markdown
+const (
+	ResultsTOCWidth        = 30
+	ResultsHeadingMatchLen = 24
+)
+
+// Minimum terminal size the wizard layouts are designed for
+const (
+	MinTerminalWidth  = 60
+	MinTerminalHeight = 20
+)
+
+// AnalyzingCompactHeight is the terminal height below which the analysis
+// screen drops its header and progress bar to give the output more room
+const AnalyzingCompactHeight = 28
+
+// Analysis timeouts used when the config does not set its own
+const (
+	DefaultIdleTimeout     = 5 * time.Minute
+	DefaultAnalysisTimeout = 10 * time.Minute
+)
+
+// SpinnerStyles are the frame sets selectable with "spinner_style"
+var SpinnerStyles = map[string][]string{
+	"braille": {"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"},
+	"dots":    {"∙∙∙", "●∙∙", "∙●∙", "∙∙●"},
+	"line":    {"─", "╲", "│", "╱"},
+	"ascii":   {"|", "/", "-", "\\"},
+}
+
+const (
+	// DefaultSpinnerStyle is used when the config does not name a style
+	DefaultSpinnerStyle = "braille"
+	// ASCIISpinnerStyle replaces the default on terminals without color
+	// or a UTF-8 locale, where Unicode frames may show up as boxes
+	ASCIISpinnerStyle = "ascii"
+)
+
+// ShutdownTimeout is how long quitting waits for a cancelled analysis to
+// clean up after itself before the CLI exits anyway
+const ShutdownTimeout = 3 * time.Second
+
+// Silence from the analysis before the analyzing screen notes that it is
+// waiting for the provider, and before it asks whether to keep waiting
+const (
+	ProviderWaitNotice  = 15 * time.Second
+	ProviderSlowTimeout = 60 * time.Second
+)
+
+// Skene sign-in: the verifying screen stays up for DefaultAuthVerifyDelay
+// unless "auth_verify_delay_ms" is set, and a check that has not finished
+// after AuthVerifyTimeout falls back to manual key entry
+const (
+	DefaultAuthVerifyDelay = 2 * time.Second
+	AuthVerifyTimeout      = 15 * time.Second
+)
+
+// DefaultMinPython is the lowest Python release skene-growth is run under
+// when the config does not set "min_python"
+const DefaultMinPython = "3.11"
+
+// SysCheckCommandTimeout bounds each external command a system check runs,
+// so a hanging shim is reported as a failure instead of blocking
+const SysCheckCommandTimeout = 10 * time.Second
+
+// Outbound HTTP timeouts. Probes and API calls are short; the uv archive
+// download gets longer on slow links.
+const (
+	HTTPTimeout       = 5 * time.Second
+	UVDownloadTimeout = 5 * time.Minute
+)
+
+// Identical analysis failures in a row before retrying is discouraged
+const MaxIdenticalFailures = 3
+
+// Analyses a --batch run may have in flight at once. They share the
+// provider's rate limits, so this stays small.
+const MaxBatchConcurrency = 4
+
+// Completed analyses kept in the history file
+const MaxHistoryEntries = 50
+
+// Repository size pre-scan; above the file threshold the user is advised
+// to narrow the scan before running
+const (
+	LargeRepoFileThreshold = 50000
+	RepoScanTimeout        = 2 * time.Second
+)
+
+// Incremental scan: the snapshot walk gives up after ScanCacheTimeout
+const ScanCacheTimeout = 10 * time.Second
+
 // Package and directory names
 const (
-	GrowthPackageName  = "skene-growth"
-	OutputDirName      = "skene-context"
-	DefaultOutputDir   = "./skene-context"
-	SkeneCacheDir      = ".skene"
-	SkeneCacheBinDir   = "bin"
-	ProjectConfigFile  = ".skene.config"
-	UserConfigDir      = ".config/skene"
-	UserConfigFile     = "config"
+	GrowthPackageName = "skene-growth"
+	OutputDirName     = "skene-context"
+	ArchiveDirName    = "archive"
+	DefaultOutputDir  = "./skene-context"
+	SkeneCacheDir     = ".skene"
+	SkeneCacheBinDir  = "bin"
+	ProjectConfigFile = ".skene.config"
+	UserConfigDir     = ".config/skene"
+	UserConfigFile    = "config"
+	CloneDirPrefix    = "skene-clone-"
+	ModelCacheFile    = "models.json"
+	HistoryFile       = "history.json"
 )
 
+// ProjectConfigYAMLFile is where a new project config saved as YAML goes
+const ProjectConfigYAMLFile = ".skene.yaml"
+
+// CrashReportFile names a crash report in the user config directory; the
+// placeholder is the time of the crash
+const CrashReportFile = "crash-%s.log"
+
+// ProjectConfigFiles are the accepted project config names, in the order a
+// directory is searched
+var ProjectConfigFiles = []string{ProjectConfigFile, ProjectConfigYAMLFile, ".skene.yml"}
+
 // Output file names
 const (
 	GrowthPlanFile           = "growth-plan.md"
@@ -41,8 +183,29 @@ const (
 	GrowthManifestFile       = "growth-manifest.json"
 	ProductDocsFile          = "product-docs.md"
 	ImplementationPromptFile = "implementation-prompt.md"
+	ResultsJSONFile          = "results.json"
+	ScanCacheFile            = ".scan-cache.json"
 )
 
+// Artifact types, the keys of the "output_files" config that renames the
+// output files
+const (
+	ArtifactGrowthManifest       = "growth_manifest"
+	ArtifactGrowthTemplate       = "growth_template"
+	ArtifactGrowthPlan           = "growth_plan"
+	ArtifactProductDocs          = "product_docs"
+	ArtifactImplementationPrompt = "implementation_prompt"
+)
+
+// DefaultOutputFiles names the file each artifact type is written to
+var DefaultOutputFiles = map[string]string{
+	ArtifactGrowthManifest:       GrowthManifestFile,
+	ArtifactGrowthTemplate:       GrowthTemplateFile,
+	ArtifactGrowthPlan:           GrowthPlanFile,
+	ArtifactProductDocs:          ProductDocsFile,
+	ArtifactImplementationPrompt: ImplementationPromptFile,
+}
+
 // Skene ecosystem package metadata
 type PackageMeta struct {
 	ID          string
@@ -51,6 +214,29 @@ type PackageMeta struct {
 	URL         string
 }
 
+// ProjectMarkers are files or folders whose presence suggests a directory
+// is a project root
+var ProjectMarkers = []string{
+	"package.json", "pyproject.toml", "requirements.txt",
+	"go.mod", "Cargo.toml", "pom.xml", "build.gradle",
+	".git", "Makefile",
+}
+
+// Endpoint is a well-known OpenAI-compatible API offered as a base URL preset
+type Endpoint struct {
+	Name string
+	URL  string
+}
+
+// GenericEndpoints prefill the base URL for the generic provider. Azure needs
+// the resource and deployment names filled in.
+var GenericEndpoints = []Endpoint{
+	{Name: "Together", URL: "https://api.together.xyz/v1"},
+	{Name: "Groq", URL: "https://api.groq.com/openai/v1"},
+	{Name: "OpenRouter", URL: "https://openrouter.ai/api/v1"},
+	{Name: "Azure", URL: "https://RESOURCE.openai.azure.com/openai/deployments/DEPLOYMENT"},
+}
+
 var SkenePackages = []PackageMeta{
 	{
 		ID:          "growth",
@@ -5,22 +5,32 @@ const (
 	StepNameAIProvider       = "AI Provider"
 	StepNameSelectModel      = "Select Model"
 	StepNameAuthentication   = "Authentication"
-	StepNameLocalModelSetup  = "Local Model Setup"
 	StepNameProjectDir       = "Project Directory"
 	StepNameProjectSetup     = "Project Setup"
 	StepNameAnalysisConfig   = "Analysis Configuration"
 	StepNameAnalyzing        = "Running Skene Growth Analysis"
 	StepNameAnalysingStepper = "Analysing"
 	StepNameResults          = "Analysis Results"
+	StepNameRegenerate       = "Regenerating %s"
 	StepNameNextSteps        = "Next Steps"
+	StepNameCloning          = "Cloning Repository"
+	StepNameHistory          = "Analysis History"
+	StepNameWelcome          = "Welcome"
+	StepNameAPIKey           = "API Key"
+	StepNameError            = "Error"
+	StepNameGame             = "Mini Game"
 	StepCounterFormat        = "Step %d of %d"
 )
 
+// Shown in place of a screen whose view has not been built
+const ViewInitializing = "Initializing %s..."
+
 // Dashboard tab names
 const (
 	TabGrowthManifest = "Growth Manifest"
 	TabGrowthTemplate = "Growth Template"
 	TabGrowthPlan     = "Growth Plan"
+	TabChanges        = "Changes"
 )
 
 // Dashboard placeholder content
@@ -58,11 +68,53 @@ The plan will contain:
 const (
 	ResultsBanner    = "Skene Analysis Complete"
 	ResultsNextSteps = "Press 'n' for next steps"
+	ResultsLoops     = "%d growth loops"
+	ResultsHighPrio  = "%d high-priority opportunities"
+	ResultsStack     = "Stack: %s"
+	ResultsTOCTitle  = "Contents"
+	ResultsDashboard = "Could not open a browser. Dashboard: %s"
+)
+
+// Model view
+const (
+	ModelListBuiltIn     = "built-in list"
+	ModelListLive        = "fetched %s"
+	ModelListFetching    = "Fetching the latest models…"
+	ModelListFetchFailed = "Could not fetch models: %v. Showing the current list."
+	ModelListNeedsKey    = "Enter an API key for %s first: choose a model, then press esc on the API key screen and fetch again."
+	ModelListUnsupported = "%s does not list its models; showing the built-in list."
+)
+
+// History view
+const (
+	HistoryEmpty = "No analyses yet. Completed runs are listed here, as long as their output folder still exists."
+	HistoryCount = "%d / %d analyses"
+)
+
+// Cloning view
+const (
+	CloneConnecting = "Connecting…"
+	CloneHint       = "Shallow clone into a temporary directory. Private repositories use your git credentials (credential helper or SSH agent)."
+	CloneKept       = "Clone kept at %s"
+)
+
+// Crash report
+const (
+	CrashReportSaved     = "Skene crashed; report saved to %s"
+	CrashReportFailed    = "Skene crashed and the report could not be saved (%v):"
+	CrashBackgroundPanic = "panic in a background command (stack trace printed above)"
 )
 
 // Next steps view
 const (
-	NextStepsSuccess = "Analysis complete! What would you like to do next?"
+	NextStepsSuccess     = "Analysis complete! What would you like to do next?"
+	NextStepsFilesHeader = "Output Files"
+	NextStepsNoFiles     = "No output files found"
+	NextStepsCopied      = "Copied %s"
+	NextStepsCopyFailed  = "Could not copy to clipboard: %s"
+	NextStepsSaved       = "Saved configuration to %s"
+	NextStepsSaveFailed  = "Could not save configuration: %s"
+	NextStepsKeyWithheld = " (API key not saved; set \"persist_api_key\": true to include it)"
 )
 
 // Next step action definitions
@@ -110,6 +162,24 @@ var NextStepActions = []NextStepDef{
 		Description: "Modify provider, model, or project settings",
 		Command:     "",
 	},
+	{
+		ID:          "save-project",
+		Name:        "Save Config for This Project",
+		Description: "Write the current settings to .skene.config",
+		Command:     "",
+	},
+	{
+		ID:          "save-project-yaml",
+		Name:        "Save Project Config as YAML",
+		Description: "Write the current settings as YAML, easier to edit by hand",
+		Command:     "",
+	},
+	{
+		ID:          "save-user",
+		Name:        "Save Config for All Projects",
+		Description: "Write the current settings to ~/.config/skene/config",
+		Command:     "",
+	},
 	{
 		ID:          "exit",
 		Name:        "Exit",
@@ -118,10 +188,18 @@ var NextStepActions = []NextStepDef{
 	},
 }
 
+// Terminal size guard
+const (
+	TerminalTooSmall     = "Please enlarge your terminal (min %d×%d)"
+	TerminalTooSmallSize = "Current size: %d×%d"
+)
+
 // Welcome view
 const (
 	WelcomeSubtitle = "Product-Led Growth analysis for your codebase"
 	WelcomeCTA      = "> ENTER <"
+	WelcomeUpdate   = "update available: %s"
+	WelcomeSaved    = "press s to reuse %s"
 )
 
 // Auth view
@@ -136,6 +214,15 @@ const (
 	AuthFallbackMessage = "Browser auth cancelled."
 	AuthFallbackSub     = "You can enter your Skene API key manually."
 	AuthFallbackHint    = "Press Enter to continue to manual entry"
+	AuthBrowserFailed   = "Could not open a browser: %s"
+	AuthRemoteMessage   = "No browser available in this session (SSH or no display)."
+	AuthRemoteSub       = "Open the URL below on any machine and sign in. If the page cannot reach this machine, copy your API key from it and press Enter to paste it here."
+	AuthOpenManually    = "Open this URL manually to sign in:"
+	AuthCopyHint        = "Press c to copy the URL"
+	AuthURLCopied       = "URL copied to the clipboard"
+	AuthURLCopyFailed   = "Could not copy: %s"
+	AuthKeyRejected     = "Could not use the API key: %s"
+	AuthKeyCheckTimeout = "the check did not finish within %s"
 )
 
 // API key view
@@ -145,6 +232,12 @@ const (
 	APIKeyValidated       = "API key validated"
 	APIKeyTooShort        = "API key is too short"
 	APIKeyBaseURLRequired = "Base URL is required for generic providers"
+	APIKeyLength          = "%d chars entered"
+	APIKeyFromFile        = "key will be read from %s"
+	APIKeyPresets         = "Preset: "
+	APIKeyPresetCustom    = "Custom"
+	APIKeyInsecureLabel   = "Skip TLS certificate verification (insecure)"
+	APIKeyInsecureWarning = "Certificates are not checked, so traffic can be intercepted. Only use this for a trusted internal gateway with a self-signed certificate."
 )
 
 // Provider-specific validation messages
@@ -171,16 +264,47 @@ const (
 
 // Analysis config view
 const (
-	AnalysisConfigSummary   = "Analysis Summary"
-	AnalysisConfigRunButton = "Run Analysis"
+	AnalysisConfigSummary      = "Analysis Summary"
+	AnalysisConfigRunButton    = "Run Analysis"
+	AnalysisConfigPhases       = "Options"
+	AnalysisConfigDocs         = "Product docs (product-docs.md)"
+	AnalysisConfigLargeRepo    = "Large repository: %s files"
+	AnalysisConfigLargeRepoTip = "Scanning all of it is slow and may not fit the model's context. Narrow it with exclude_folders in the config, or press enter to run anyway."
+)
+
+// Return-home confirmation
+const (
+	HomeConfirmTitle   = "Analysis in progress"
+	HomeConfirmMessage = "Cancel the running analysis and return to the start?"
+	HomeConfirmHint    = "y / ctrl+h to confirm · any other key to keep going"
+)
+
+// Growth plan regeneration confirmation
+const (
+	RegenerateConfirmTitle   = "Regenerate the growth plan?"
+	RegenerateConfirmMessage = "Runs skene-growth plan again and replaces %s. The manifest and template are kept."
+	RegenerateConfirmHint    = "y to confirm · any other key to cancel"
 )
 
 // Analyzing view
 const (
-	AnalyzingFailed   = "Failed"
-	AnalyzingComplete = "Complete"
-	AnalyzingRunning  = "Running..."
-	AnalyzingDone     = "Done"
+	AnalyzingFailed    = "Failed"
+	AnalyzingComplete  = "Complete"
+	AnalyzingRunning   = "Running..."
+	AnalyzingDone      = "Done"
+	AnalyzingPhaseTime = "Phase %d/%d · %s"
+	AnalyzingETA       = "ETA ~%s"
+
+	// Shown when the analysis has produced no output for a while
+	AnalyzingWaiting        = "waiting for provider · %s"
+	ProviderSlowQuestion    = "The provider is slow to respond (no output for %s). Cancel or keep waiting?"
+	ProviderSlowKeepWaiting = "Keep waiting"
+	ProviderSlowCancel      = "Cancel analysis"
+
+	// Search over the output of a finished run
+	AnalyzingSearchPlaceholder = "search output"
+	AnalyzingSearchCount       = "%d of %d"
+	AnalyzingSearchNone        = "no matches"
 )
 
 // Analysis phase names are now defined in internal/services/growth/engine.go
@@ -188,8 +312,33 @@ const (
 
 // Error view
 const (
-	ErrorAnalysisFailed = "ANALYSIS_FAILED"
-	ErrorAnalysisTitle  = "Analysis Failed"
+	ErrorAnalysisFailed  = "ANALYSIS_FAILED"
+	ErrorAnalysisTitle   = "Analysis Failed"
+	ErrorModelNotFound   = "MODEL_NOT_FOUND"
+	ErrorModelTitle      = "Model Not Available"
+	ErrorModelMessage    = "%s rejected the model %s."
+	ErrorModelDidYouMean = "%s not found; did you mean %s? Press enter to switch and retry."
+	ErrorModelNoList     = "Check the model name for typos, and that your account has access to it. Change Configuration lets you pick another model."
+	ErrorQuotaExceeded   = "QUOTA_EXCEEDED"
+	ErrorQuotaTitle      = "Out of Quota"
+	ErrorQuotaMessage    = "%s refused the request because the account has run out of quota or credit."
+	ErrorQuotaCheaper    = "Retry with %s, the cheapest %s model, or add credit to your account and retry."
+	ErrorQuotaNoCheaper  = "Add credit or raise the spending limit on your account and retry, or pick another provider with Change Configuration."
+	ErrorDetailsCopied   = "Error details copied to the clipboard"
+	UVInstallStarting    = "Downloading uv..."
+	UVInstallProgress    = "Downloading uv... %d%%"
+	UVInstallProgressMB  = "Downloading uv... %.1f MB"
+	UVInstallFailedFmt   = "Install failed: %v. See the suggested fix for other ways to install uv."
+	UVInstallCopied      = "Copied: %s"
+	ErrorRepeatedFailure = "This failed the same way %d times with the current configuration, so retrying is unlikely to help. Check the provider, model and API key."
+	ErrorPythonMessage   = "Python %s+ is required but uv could not find it."
+	ErrorPythonFix       = "Install Python %s+ from python.org or your package manager, or with 'uv python install %s'. If your tooling supports an older release, lower \"min_python\" in your config."
+)
+
+// Directory browser
+const (
+	DirBrowserHiddenOn  = "hidden: on"
+	DirBrowserHiddenOff = "hidden: off"
 )
 
 // Button labels
@@ -200,17 +349,20 @@ const (
 	ButtonBrowse     = "Browse"
 	ButtonSelectDir  = "Select This Directory"
 	ButtonCancel     = "Cancel"
-)
-
-// Local model view
-const (
-	LocalModelSelectHeader = "Select a local model"
-	LocalModelRetryHint    = "Press 'r' to retry detection or 'esc' to go back"
+	ButtonRetry      = "Retry"
+	ButtonGoBack     = "Go Back"
+	ButtonReconfig   = "Change Configuration"
+	ButtonCopyError  = "Copy Details"
+	ButtonUseModel   = "Retry with %s"
+	ButtonInstallUV  = "Install uv"
+	ButtonInstallCmd = "Copy Install Command"
+	ButtonReauth     = "Re-authenticate"
 )
 
 // Help key labels
 const (
 	HelpKeyUpDown    = "↑/↓"
+	HelpKeyNumbers   = "1-9"
 	HelpKeyLeftRight = "←/→"
 	HelpKeyEnter     = "enter"
 	HelpKeyEsc       = "esc"
@@ -220,23 +372,42 @@ const (
 	HelpKeyHelp      = "?"
 	HelpKeyN         = "n"
 	HelpKeyG         = "g"
+	HelpKeyD         = "d"
 	HelpKeyM         = "m"
 	HelpKeyR         = "r"
+	HelpKeyCtrlH     = "ctrl+h"
+	HelpKeyCtrlR     = "ctrl+r"
+	HelpKeyC         = "c"
+	HelpKeyT         = "t"
+	HelpKeyF         = "f"
+	HelpKeyO         = "o"
+	HelpKeyP         = "p"
+	HelpKeyS         = "s"
+	HelpKeyPgUpDown  = "pgup/pgdn"
+	HelpKeyHomeEnd   = "home/end"
+	HelpKeySlash     = "/"
+	HelpKeyDot       = "."
+	HelpKeyNextPrev  = "n/N"
 )
 
 // Help descriptions
 const (
 	HelpDescNavigate       = "navigate"
 	HelpDescSelect         = "select"
+	HelpDescPickOption     = "pick option"
 	HelpDescSelectOption   = "select option"
 	HelpDescSelectProvider = "select provider"
 	HelpDescSelectModel    = "select model"
+	HelpDescFetchModels    = "fetch latest models"
 
 	HelpDescConfirm          = "confirm"
 	HelpDescConfirmSelection = "confirm selection"
 	HelpDescSubmit           = "submit"
 	HelpDescContinue         = "continue"
 	HelpDescStart            = "start"
+	HelpDescUseSaved         = "use saved config"
+	HelpDescHistory          = "past analyses"
+	HelpDescViewResults      = "view results"
 	HelpDescStartAnalysis    = "start analysis"
 	HelpDescGoBack           = "go back"
 	HelpDescBack             = "back"
@@ -245,12 +416,16 @@ const (
 	HelpDescCancelGoBack     = "cancel and go back"
 	HelpDescQuit             = "quit"
 	HelpDescScroll           = "scroll"
+	HelpDescPage             = "page"
+	HelpDescTopBottom        = "top/bottom"
 	HelpDescSwitchTabs       = "switch tabs"
 	HelpDescFocusContent     = "focus content"
 	HelpDescFocusTabs        = "focus tabs"
 	HelpDescFocus            = "focus"
 	HelpDescSwitchFocus      = "switch focus"
 	HelpDescSwitchField      = "switch field"
+	HelpDescEndpointPreset   = "endpoint preset"
+	HelpDescRevealKey        = "show/hide key"
 	HelpDescToggleHelp       = "toggle help"
 	HelpDescHelp             = "help"
 	HelpDescNextSteps        = "next steps"
@@ -264,5 +439,22 @@ const (
 	HelpDescBackToProvider   = "go back to provider selection"
 	HelpDescToggleOption     = "toggle option"
 	HelpDescOpenFolder       = "open folder"
+	HelpDescHiddenFiles      = "show/hide dotfiles"
 	HelpDescTabs             = "tabs"
+	HelpDescSelectLoop       = "select loop"
+	HelpDescRawPlan          = "toggle raw plan"
+	HelpDescContents         = "contents"
+	HelpDescCloseContents    = "close contents"
+	HelpDescJumpSection      = "jump to section"
+	HelpDescRegenerate       = "regenerate plan"
+	HelpDescDashboard        = "dashboard"
+	HelpDescHome             = "back to start"
+	HelpDescCopyPath         = "copy path"
+	HelpDescOpenFile         = "open file"
+	HelpDescCopyDetails      = "copy error details"
+	HelpDescCopyURL          = "copy URL"
+	HelpDescSearch           = "search"
+	HelpDescNextMatch        = "next/prev match"
+	HelpDescClearSearch      = "clear search"
+	HelpDescTimestamps       = "timestamps"
 )
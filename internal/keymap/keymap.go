@@ -0,0 +1,324 @@
+package keymap
+
+import (
+	"fmt"
+	"sort"
+	"unicode/utf8"
+)
+
+// Action is a logical key binding that screens respond to
+type Action string
+
+const (
+	None   Action = ""
+	Up     Action = "up"
+	Down   Action = "down"
+	Left   Action = "left"
+	Right  Action = "right"
+	Select Action = "select"
+	Back   Action = "back"
+	Quit   Action = "quit"
+	Help   Action = "help"
+	Game   Action = "game"
+
+	// Screen actions, handled only on the screens listed in screens
+	UseSaved     Action = "use_saved"
+	History      Action = "history"
+	FetchModels  Action = "fetch_models"
+	Copy         Action = "copy"
+	ManualEntry  Action = "manual_entry"
+	RevealKey    Action = "reveal_key"
+	Toggle       Action = "toggle"
+	ParentDir    Action = "parent_dir"
+	ToggleHidden Action = "toggle_hidden"
+	Search       Action = "search"
+	NextMatch    Action = "next_match"
+	PrevMatch    Action = "prev_match"
+	Timestamps   Action = "timestamps"
+	Contents     Action = "contents"
+	Regenerate   Action = "regenerate"
+	Dashboard    Action = "dashboard"
+	RawPlan      Action = "raw_plan"
+	NextSteps    Action = "next_steps"
+	Open         Action = "open"
+	MoveLeft     Action = "move_left"
+	MoveRight    Action = "move_right"
+	Shoot        Action = "shoot"
+	Pause        Action = "pause"
+	Restart      Action = "restart"
+)
+
+// Actions lists every action that can be bound, in display order
+var Actions = []Action{
+	Up, Down, Left, Right, Select, Back, Quit, Help, Game,
+	UseSaved, History, FetchModels, Copy, ManualEntry, RevealKey, Toggle,
+	ParentDir, ToggleHidden, Search, NextMatch, PrevMatch, Timestamps,
+	Contents, Regenerate, Dashboard, RawPlan, NextSteps, Open,
+	MoveLeft, MoveRight, Shoot, Pause, Restart,
+}
+
+// screens lists where each screen action is handled. Actions not listed
+// are global, so their keys must not be reused on any screen; a screen
+// action's keys only have to be unique on its own screens.
+var screens = map[Action][]string{
+	UseSaved:     {"welcome"},
+	History:      {"welcome"},
+	FetchModels:  {"model"},
+	Copy:         {"auth", "next_steps", "error"},
+	ManualEntry:  {"auth"},
+	RevealKey:    {"api_key"},
+	Toggle:       {"api_key", "analysis_config"},
+	ParentDir:    {"project_dir"},
+	ToggleHidden: {"project_dir"},
+	Search:       {"analyzing"},
+	NextMatch:    {"analyzing"},
+	PrevMatch:    {"analyzing"},
+	Timestamps:   {"analyzing"},
+	Contents:     {"results"},
+	Regenerate:   {"results"},
+	Dashboard:    {"results"},
+	RawPlan:      {"results"},
+	NextSteps:    {"results"},
+	Open:         {"next_steps", "history"},
+	MoveLeft:     {"game"},
+	MoveRight:    {"game"},
+	Shoot:        {"game"},
+	Pause:        {"game"},
+	Restart:      {"game"},
+}
+
+// reserved are keys the screens handle directly, which cannot be bound to
+// another action: ctrl+c always quits, ctrl+h goes home, tab moves focus,
+// the paging keys scroll the results and digits answer analysis prompts
+var reserved = map[string]Action{
+	"ctrl+c": Quit,
+	"ctrl+h": None,
+	"tab":    None,
+	"pgup":   None,
+	"pgdown": None,
+	"home":   None,
+	"end":    None,
+}
+
+// screenKeys are the screen action bindings shared by every preset
+var screenKeys = map[Action][]string{
+	UseSaved:     {"s"},
+	History:      {"r"},
+	FetchModels:  {"f"},
+	Copy:         {"c"},
+	ManualEntry:  {"m"},
+	RevealKey:    {"ctrl+r"},
+	Toggle:       {" "},
+	ParentDir:    {"backspace"},
+	ToggleHidden: {"."},
+	Search:       {"/"},
+	NextMatch:    {"n"},
+	PrevMatch:    {"N"},
+	Timestamps:   {"t"},
+	Contents:     {"t"},
+	Regenerate:   {"p"},
+	Dashboard:    {"d"},
+	RawPlan:      {"r"},
+	NextSteps:    {"n"},
+	Open:         {"o"},
+	MoveLeft:     {"a"},
+	MoveRight:    {"d"},
+	Shoot:        {" "},
+	Pause:        {"p"},
+	Restart:      {"r"},
+}
+
+// Presets are the built-in binding sets selectable with "key_bindings".
+// Key names are Bubble Tea's KeyMsg strings.
+var Presets = map[string]map[Action][]string{
+	"default": {
+		Up:     {"up", "k"},
+		Down:   {"down", "j"},
+		Left:   {"left", "h"},
+		Right:  {"right", "l"},
+		Select: {"enter"},
+		Back:   {"esc"},
+		Quit:   {"ctrl+c"},
+		Help:   {"?"},
+		Game:   {"g"},
+	},
+	"vim": {
+		Up:     {"k", "up", "ctrl+p"},
+		Down:   {"j", "down", "ctrl+n"},
+		Left:   {"h", "left"},
+		Right:  {"l", "right"},
+		Select: {"enter"},
+		Back:   {"esc", "q"},
+		Quit:   {"ctrl+c"},
+		Help:   {"?"},
+		Game:   {"g"},
+	},
+	"emacs": {
+		Up:     {"up", "ctrl+p"},
+		Down:   {"down", "ctrl+n"},
+		Left:   {"left", "ctrl+b"},
+		Right:  {"right", "ctrl+f"},
+		Select: {"enter"},
+		Back:   {"esc", "ctrl+g"},
+		Quit:   {"ctrl+c"},
+		Help:   {"?"},
+		Game:   {"g"},
+	},
+}
+
+// DefaultPreset is used when the config does not name one
+const DefaultPreset = "default"
+
+// KeyMap resolves pressed keys to actions
+type KeyMap struct {
+	actions  map[string]Action
+	bindings map[Action][]string
+}
+
+// New builds a key map from a preset, replacing the keys of any action
+// listed in overrides. An empty preset selects DefaultPreset.
+func New(preset string, overrides map[string][]string) (*KeyMap, error) {
+	if err := Check(preset, overrides); err != nil {
+		return nil, err
+	}
+
+	km := &KeyMap{actions: make(map[string]Action), bindings: resolve(preset, overrides)}
+	for _, action := range Actions {
+		if isScreenAction(action) {
+			continue
+		}
+		for _, key := range km.bindings[action] {
+			km.actions[key] = action
+		}
+	}
+	return km, nil
+}
+
+// Default returns the key map of DefaultPreset
+func Default() *KeyMap {
+	km, _ := New(DefaultPreset, nil)
+	return km
+}
+
+// Check reports an unknown preset or action name, a reserved key, and a
+// key bound to two actions that can be pressed on the same screen
+func Check(preset string, overrides map[string][]string) error {
+	if _, ok := Presets[preset]; preset != "" && !ok {
+		return fmt.Errorf("unknown key_bindings preset %q (choose from %s)", preset, presetNames())
+	}
+	for name := range overrides {
+		if !isAction(Action(name)) {
+			return fmt.Errorf("unknown key_map action %q", name)
+		}
+	}
+
+	bound := make(map[string][]Action)
+	bindings := resolve(preset, overrides)
+	// Iterate in a fixed order so the same conflict is always reported
+	for _, action := range Actions {
+		for _, key := range bindings[action] {
+			if owner, ok := reservedBy(key); ok && owner != action {
+				return fmt.Errorf("key %q is reserved and cannot be bound to %s", key, action)
+			}
+			// Pressed while typing the API key, where a plain character
+			// has to be typed instead
+			if action == RevealKey && utf8.RuneCountInString(key) == 1 {
+				return fmt.Errorf("%s is pressed while typing, so %q needs a modifier such as ctrl", action, key)
+			}
+			for _, other := range bound[key] {
+				if other != action && shareScreen(other, action) {
+					return fmt.Errorf("key %q is bound to both %s and %s", key, other, action)
+				}
+			}
+			bound[key] = append(bound[key], action)
+		}
+	}
+	return nil
+}
+
+// resolve returns the keys of each action: the preset's, replaced by any
+// overrides
+func resolve(preset string, overrides map[string][]string) map[Action][]string {
+	if preset == "" {
+		preset = DefaultPreset
+	}
+	bindings := make(map[Action][]string, len(Actions))
+	for action, keys := range screenKeys {
+		bindings[action] = keys
+	}
+	for action, keys := range Presets[preset] {
+		bindings[action] = keys
+	}
+	for name, keys := range overrides {
+		bindings[Action(name)] = keys
+	}
+	return bindings
+}
+
+// Action returns the global action key is bound to. While typing into a
+// text field, single-character bindings are ignored so letters like j and k
+// can be typed.
+func (k *KeyMap) Action(key string, typing bool) Action {
+	if typing && utf8.RuneCountInString(key) == 1 {
+		return None
+	}
+	return k.actions[key]
+}
+
+// Is reports whether key is bound to action. Screens use it for their own
+// actions, whose keys can be reused on other screens.
+func (k *KeyMap) Is(key string, action Action) bool {
+	for _, bound := range k.bindings[action] {
+		if bound == key {
+			return true
+		}
+	}
+	return false
+}
+
+func isAction(action Action) bool {
+	for _, a := range Actions {
+		if a == action {
+			return true
+		}
+	}
+	return false
+}
+
+// reservedBy reports whether key is reserved, and for which action
+func reservedBy(key string) (Action, bool) {
+	if len(key) == 1 && key >= "1" && key <= "9" {
+		return None, true
+	}
+	owner, ok := reserved[key]
+	return owner, ok
+}
+
+func isScreenAction(action Action) bool {
+	_, ok := screens[action]
+	return ok
+}
+
+// shareScreen reports whether a and b can both be pressed on one screen
+func shareScreen(a, b Action) bool {
+	if !isScreenAction(a) || !isScreenAction(b) {
+		return true
+	}
+	for _, s := range screens[a] {
+		for _, t := range screens[b] {
+			if s == t {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func presetNames() string {
+	names := make([]string, 0, len(Presets))
+	for name := range Presets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return fmt.Sprint(names)
+}
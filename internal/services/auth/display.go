@@ -0,0 +1,21 @@
+package auth
+
+import (
+	"os"
+	"runtime"
+)
+
+// CanOpenBrowser reports whether a browser launched from this process is
+// likely to reach the user. It returns false over SSH and on Unix systems
+// without an X11 or Wayland display, where browser.OpenURL fails or opens
+// nothing visible.
+func CanOpenBrowser() bool {
+	if os.Getenv("SSH_CONNECTION") != "" || os.Getenv("SSH_TTY") != "" {
+		return false
+	}
+	switch runtime.GOOS {
+	case "darwin", "windows":
+		return true
+	}
+	return os.Getenv("DISPLAY") != "" || os.Getenv("WAYLAND_DISPLAY") != ""
+}
@@ -0,0 +1,23 @@
+package auth
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+)
+
+// ErrMalformedKey is returned for a key that cannot be a Skene API key
+var ErrMalformedKey = errors.New("the sign-in page returned a malformed API key")
+
+// CheckKeyFormat rejects an API key from the sign-in page that is empty or
+// contains whitespace or control characters. Skene has no endpoint for
+// checking a key, so a wrong but well-formed key is only caught by the
+// first analysis.
+func CheckKeyFormat(key string) error {
+	if strings.TrimSpace(key) == "" || strings.IndexFunc(key, func(r rune) bool {
+		return unicode.IsSpace(r) || unicode.IsControl(r)
+	}) >= 0 {
+		return ErrMalformedKey
+	}
+	return nil
+}
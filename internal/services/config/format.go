@@ -0,0 +1,99 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Format is the encoding of a config file
+type Format string
+
+const (
+	FormatJSON Format = "json"
+	FormatYAML Format = "yaml"
+)
+
+// DetectFormat picks the format from the file extension, and for names
+// without one (.skene.config, ~/.config/skene/config) from the content:
+// JSON always starts with "{", anything else is read as YAML.
+func DetectFormat(path string, data []byte) Format {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return FormatYAML
+	case ".json":
+		return FormatJSON
+	}
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 || trimmed[0] == '{' {
+		return FormatJSON
+	}
+	return FormatYAML
+}
+
+// decodeConfig fills config from data. YAML is converted to JSON first so
+// both formats share the json tags, defaults and, when strict, the check
+// for unknown keys.
+func decodeConfig(format Format, data []byte, config *Config, strict bool) error {
+	if format == FormatYAML {
+		var doc interface{}
+		if err := yaml.Unmarshal(data, &doc); err != nil {
+			return err
+		}
+		if doc == nil {
+			return nil
+		}
+		converted, err := json.Marshal(doc)
+		if err != nil {
+			return err
+		}
+		data = converted
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if strict {
+		dec.DisallowUnknownFields()
+	}
+	return dec.Decode(config)
+}
+
+// encodeConfig renders config in format. The YAML keeps the field order of
+// the JSON, since JSON is itself YAML and parses into ordered nodes.
+func encodeConfig(format Format, config *Config) ([]byte, error) {
+	data, err := json.MarshalIndent(config, "", "  ")
+	if err != nil || format != FormatYAML {
+		return data, err
+	}
+
+	var node yaml.Node
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		return nil, err
+	}
+	blockStyle(&node)
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&node); err != nil {
+		return nil, fmt.Errorf("failed to encode YAML: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return nil, fmt.Errorf("failed to encode YAML: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// blockStyle drops the flow style and quoting carried over from JSON, so
+// the YAML reads as if written by hand. Empty lists and maps stay inline.
+func blockStyle(node *yaml.Node) {
+	if len(node.Content) > 0 || node.Kind == yaml.ScalarNode {
+		node.Style = 0
+	}
+	for _, child := range node.Content {
+		blockStyle(child)
+	}
+}
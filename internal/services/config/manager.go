@@ -3,8 +3,11 @@ package config
 import (
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
 	"skene/internal/constants"
 )
@@ -18,7 +21,86 @@ type Config struct {
 	Verbose      bool   `json:"verbose"`
 	ProjectDir   string `json:"project_dir"`
 	BaseURL      string `json:"base_url,omitempty"`
+
+	// GenericBaseURL is the last base URL used with the generic provider,
+	// prefilled the next time it is chosen
+	GenericBaseURL string `json:"generic_base_url,omitempty"`
 	UseGrowth bool `json:"use_growth"`
+
+	// BackupPrevious archives an existing analysis before a rerun overwrites it
+	BackupPrevious bool `json:"backup_previous"`
+
+	// GenerateDocs has analyze write product-docs.md
+	GenerateDocs bool `json:"generate_docs"`
+
+	// Folder names skene-growth should not scan
+	ExcludeFolders []string `json:"exclude_folders,omitempty"`
+
+	// Timeouts in seconds; 0 uses the defaults
+	IdleTimeout     int `json:"idle_timeout,omitempty"`
+	AnalysisTimeout int `json:"analysis_timeout,omitempty"`
+
+	// AuthVerifyDelay is how long, in milliseconds, the verifying screen
+	// stays up after a Skene sign-in; 0 uses the default
+	AuthVerifyDelay int `json:"auth_verify_delay_ms,omitempty"`
+
+	// IncrementalScan lists the files changed since the last run in the
+	// analysis log
+	IncrementalScan bool `json:"incremental_scan,omitempty"`
+
+	// MinPython is the lowest Python release, as "MAJOR.MINOR", that uv may
+	// run skene-growth under; empty uses 3.11
+	MinPython string `json:"min_python,omitempty"`
+
+	// Update check settings
+	NoUpdateCheck  bool   `json:"no_update_check,omitempty"`
+	UpdateCheckURL string `json:"update_check_url,omitempty"`
+
+	// OutputFiles renames output files by artifact type, e.g.
+	// {"growth_plan": "GROWTH.md"}; unlisted types keep their default names
+	OutputFiles map[string]string `json:"output_files,omitempty"`
+
+	// DashboardURL is the Skene dashboard the results screen opens after a
+	// Skene analysis; empty uses the hosted dashboard
+	DashboardURL string `json:"dashboard_url,omitempty"`
+
+	// Proxy overrides HTTP_PROXY/HTTPS_PROXY for every outbound request,
+	// including the ones skene-growth makes
+	Proxy string `json:"proxy,omitempty"`
+
+	// InsecureSkipVerify disables TLS certificate checks, for self-hosted
+	// gateways with self-signed certificates
+	InsecureSkipVerify bool `json:"insecure_skip_verify,omitempty"`
+
+	// Offline skips the update check and never downloads uv or packages
+	Offline bool `json:"offline,omitempty"`
+
+	// SkipSetup starts on project selection when provider, model and key
+	// are already valid
+	SkipSetup bool `json:"skip_setup,omitempty"`
+
+	// SkipAnalysisConfig runs the analysis with the saved settings as soon
+	// as a project is chosen, without the Analysis Configuration screen
+	SkipAnalysisConfig bool `json:"skip_analysis_config,omitempty"`
+
+	// KeyBindings names a preset ("default", "vim", "emacs"); KeyMap
+	// replaces the keys of individual actions, e.g. {"game": ["x"]}
+	KeyBindings string              `json:"key_bindings,omitempty"`
+	KeyMap      map[string][]string `json:"key_map,omitempty"`
+
+	// AnimationsEnabled plays the welcome animation; false shows a static logo
+	AnimationsEnabled bool `json:"animations_enabled"`
+
+	// SpinnerStyle names a spinner preset ("braille", "dots", "line",
+	// "ascii"); empty picks ascii on terminals without color or UTF-8
+	SpinnerStyle string `json:"spinner_style,omitempty"`
+
+	// MiniGameEnabled offers the mini-game while an analysis runs
+	MiniGameEnabled bool `json:"mini_game_enabled"`
+
+	// PersistAPIKey lets the save actions write the API key to disk;
+	// otherwise a saved file keeps whatever key it already had
+	PersistAPIKey bool `json:"persist_api_key,omitempty"`
 }
 
 // Manager handles configuration file operations
@@ -26,6 +108,9 @@ type Manager struct {
 	ProjectConfigPath string
 	UserConfigPath    string
 	Config            *Config
+
+	// offline is set by SetOffline; it survives a reload and is never saved
+	offline bool
 }
 
 // NewManager creates a new config manager
@@ -33,13 +118,24 @@ func NewManager(projectDir string) *Manager {
 	homeDir, _ := os.UserHomeDir()
 
 	return &Manager{
-		ProjectConfigPath: filepath.Join(projectDir, constants.ProjectConfigFile),
+		ProjectConfigPath: findProjectConfig(projectDir),
 		UserConfigPath:    filepath.Join(homeDir, constants.UserConfigDir, constants.UserConfigFile),
-		Config: &Config{
-			OutputDir: constants.DefaultOutputDir,
-			Verbose:   true,
-			UseGrowth: true,
-		},
+		Config:            defaultConfig(),
+	}
+}
+
+// defaultConfig returns the values used for any field a config file omits
+func defaultConfig() *Config {
+	return &Config{
+		OutputDir:      constants.DefaultOutputDir,
+		Verbose:        true,
+		UseGrowth:      true,
+		BackupPrevious: true,
+
+		GenerateDocs: true,
+
+		AnimationsEnabled: true,
+		MiniGameEnabled:   true,
 	}
 }
 
@@ -79,14 +175,26 @@ func (m *Manager) CheckConfigs() []ConfigStatus {
 	return statuses
 }
 
-// LoadConfig loads configuration from files (project takes precedence)
+// LoadConfig loads configuration from files (project takes precedence),
+// then applies SKENE_PROVIDER, SKENE_MODEL and SKENE_BASE_URL on top
 func (m *Manager) LoadConfig() error {
+	m.loadConfigFiles()
+	m.applyEnvOverrides()
+	// Names are joined into output paths, so invalid ones are never used;
+	// --check-config still reports them
+	if ValidateOutputFiles(m.Config.OutputFiles) != nil {
+		m.Config.OutputFiles = nil
+	}
+	return nil
+}
+
+func (m *Manager) loadConfigFiles() {
 	// Try project config first
 	if fileExists(m.ProjectConfigPath) {
 		config, err := m.loadConfigFile(m.ProjectConfigPath)
 		if err == nil {
 			m.Config = config
-			return nil
+			return
 		}
 	}
 
@@ -95,12 +203,28 @@ func (m *Manager) LoadConfig() error {
 		config, err := m.loadConfigFile(m.UserConfigPath)
 		if err == nil {
 			m.Config = config
-			return nil
+			return
 		}
 	}
 
 	// No config found, use defaults
-	return nil
+}
+
+// applyEnvOverrides lets scripted and containerised runs pick the provider
+// without a config file. Flags are applied later and win over these.
+func (m *Manager) applyEnvOverrides() {
+	if provider := os.Getenv("SKENE_PROVIDER"); provider != "" {
+		m.Config.Provider = provider
+	}
+	if model := os.Getenv("SKENE_MODEL"); model != "" {
+		m.Config.Model = model
+	}
+	if baseURL := os.Getenv("SKENE_BASE_URL"); baseURL != "" {
+		m.Config.BaseURL = baseURL
+		if IsGenericProvider(m.Config.Provider) {
+			m.Config.GenericBaseURL = baseURL
+		}
+	}
 }
 
 func (m *Manager) loadConfigFile(path string) (*Config, error) {
@@ -109,48 +233,80 @@ func (m *Manager) loadConfigFile(path string) (*Config, error) {
 		return nil, err
 	}
 
-	var config Config
-	if err := json.Unmarshal(data, &config); err != nil {
+	config := defaultConfig()
+	if err := decodeConfig(DetectFormat(path, data), data, config, false); err != nil {
 		return nil, err
 	}
 
-	return &config, nil
+	return config, nil
 }
 
 // SaveConfig saves configuration to project config file
 func (m *Manager) SaveConfig() error {
-	// Ensure directory exists
-	dir := filepath.Dir(m.ProjectConfigPath)
-	if err := os.MkdirAll(dir, 0755); err != nil {
-		return fmt.Errorf("failed to create config directory: %w", err)
-	}
+	return m.saveTo(m.ProjectConfigPath, m.Config.ProjectDir)
+}
 
-	data, err := json.MarshalIndent(m.Config, "", "  ")
-	if err != nil {
-		return fmt.Errorf("failed to marshal config: %w", err)
+// SaveConfigAs saves the project config in the given format. An existing
+// project config keeps its name; a new YAML one is created as .skene.yaml.
+func (m *Manager) SaveConfigAs(format Format) error {
+	if format == FormatYAML && !fileExists(m.ProjectConfigPath) {
+		m.ProjectConfigPath = filepath.Join(filepath.Dir(m.ProjectConfigPath), constants.ProjectConfigYAMLFile)
 	}
+	return m.saveAs(m.ProjectConfigPath, m.Config.ProjectDir, format)
+}
 
-	if err := os.WriteFile(m.ProjectConfigPath, data, 0644); err != nil {
-		return fmt.Errorf("failed to write config: %w", err)
-	}
+// SaveUserConfig saves configuration to user config file. The project
+// directory is left out, since the user config applies to every project.
+func (m *Manager) SaveUserConfig() error {
+	return m.saveTo(m.UserConfigPath, "")
+}
 
-	return nil
+// KeyWithheld reports whether a save leaves out the API key in memory
+func (m *Manager) KeyWithheld() bool {
+	return m.Config.APIKey != "" && !m.Config.PersistAPIKey
 }
 
-// SaveUserConfig saves configuration to user config file
-func (m *Manager) SaveUserConfig() error {
+// saveTo writes the config in the format the file already uses, or JSON for
+// a new file
+func (m *Manager) saveTo(path, projectDir string) error {
+	format := FormatJSON
+	if data, err := os.ReadFile(path); err == nil {
+		format = DetectFormat(path, data)
+	} else if DetectFormat(path, nil) == FormatYAML {
+		format = FormatYAML
+	}
+	return m.saveAs(path, projectDir, format)
+}
+
+func (m *Manager) saveAs(path, projectDir string, format Format) error {
+	config := *m.Config
+	config.ProjectDir = projectDir
+	existing, err := m.loadConfigFile(path)
+	if !config.PersistAPIKey {
+		config.APIKey = ""
+		if err == nil {
+			config.APIKey = existing.APIKey
+		}
+	}
+	if m.offline {
+		config.Offline = false
+		if err == nil {
+			config.Offline = existing.Offline
+		}
+	}
+
 	// Ensure directory exists
-	dir := filepath.Dir(m.UserConfigPath)
+	dir := filepath.Dir(path)
 	if err := os.MkdirAll(dir, 0755); err != nil {
 		return fmt.Errorf("failed to create config directory: %w", err)
 	}
 
-	data, err := json.MarshalIndent(m.Config, "", "  ")
+	data, err := encodeConfig(format, &config)
 	if err != nil {
 		return fmt.Errorf("failed to marshal config: %w", err)
 	}
 
-	if err := os.WriteFile(m.UserConfigPath, data, 0644); err != nil {
+	if err := os.WriteFile(path, data, 0644); err != nil {
 		return fmt.Errorf("failed to write config: %w", err)
 	}
 
@@ -177,11 +333,41 @@ func (m *Manager) SetProjectDir(dir string) {
 	m.Config.ProjectDir = dir
 }
 
+// UseProject makes dir the project directory and switches to its project
+// config, found the same way NewManager finds it. The config is reloaded
+// when that is a different file than the one in use; the return value
+// reports whether it was.
+func (m *Manager) UseProject(dir string) bool {
+	path := findProjectConfig(dir)
+	reloaded := path != m.ProjectConfigPath
+	if reloaded {
+		m.ProjectConfigPath = path
+		m.LoadConfig()
+		if m.offline {
+			m.Config.Offline = true
+		}
+	}
+	m.SetProjectDir(dir)
+	return reloaded
+}
+
+// SetOffline turns on offline mode for this run only (--offline). Saving
+// keeps whatever "offline" the file already had.
+func (m *Manager) SetOffline() {
+	m.offline = true
+	m.Config.Offline = true
+}
+
 // SetBaseURL sets the base URL for generic providers
 func (m *Manager) SetBaseURL(url string) {
 	m.Config.BaseURL = url
 }
 
+// SetInsecureSkipVerify sets whether TLS certificates are left unchecked
+func (m *Manager) SetInsecureSkipVerify(insecure bool) {
+	m.Config.InsecureSkipVerify = insecure
+}
+
 // GetMaskedAPIKey returns masked API key for display
 func (m *Manager) GetMaskedAPIKey() string {
 	if len(m.Config.APIKey) <= 8 {
@@ -190,9 +376,26 @@ func (m *Manager) GetMaskedAPIKey() string {
 	return m.Config.APIKey[:4] + ".." + m.Config.APIKey[len(m.Config.APIKey)-4:]
 }
 
-// HasValidConfig checks if config has minimum required values
+// MaskedJSON returns the config as JSON with the API key masked and any
+// proxy credentials removed, for crash reports
+func (m *Manager) MaskedJSON() ([]byte, error) {
+	masked := *m.Config
+	if masked.APIKey != "" {
+		masked.APIKey = m.GetMaskedAPIKey()
+	}
+	if u, err := url.Parse(masked.Proxy); err == nil && u.User != nil {
+		masked.Proxy = u.Redacted()
+	}
+	return json.MarshalIndent(masked, "", "  ")
+}
+
+// HasValidConfig checks if config has minimum required values. Local
+// providers need no API key.
 func (m *Manager) HasValidConfig() bool {
-	return m.Config.Provider != "" && m.Config.Model != "" && m.Config.APIKey != ""
+	if m.Config.Provider == "" || m.Config.Model == "" {
+		return false
+	}
+	return m.Config.APIKey != "" || IsLocalProvider(m.Config.Provider)
 }
 
 // GetShortenedPath returns a shortened path for display
@@ -203,6 +406,43 @@ func GetShortenedPath(path string, maxLen int) string {
 	return "..." + path[len(path)-maxLen+3:]
 }
 
+// findProjectConfig returns the nearest project config (.skene.config,
+// .skene.yaml or .skene.yml) in projectDir or one of its parents, stopping
+// at the directory that holds .git so a config outside the repository is
+// never picked up. Without one it returns the .skene.config path in
+// projectDir, which is where SaveConfig creates it.
+func findProjectConfig(projectDir string) string {
+	fallback := filepath.Join(projectDir, constants.ProjectConfigFile)
+	dir, err := filepath.Abs(projectDir)
+	if err != nil {
+		return fallback
+	}
+	for {
+		if path := ProjectConfigIn(dir); fileExists(path) {
+			return path
+		}
+		if fileExists(filepath.Join(dir, ".git")) {
+			return fallback
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return fallback
+		}
+		dir = parent
+	}
+}
+
+// ProjectConfigIn returns the project config in dir, trying each accepted
+// name in turn, or the .skene.config path when there is none
+func ProjectConfigIn(dir string) string {
+	for _, name := range constants.ProjectConfigFiles {
+		if path := filepath.Join(dir, name); fileExists(path) {
+			return path
+		}
+	}
+	return filepath.Join(dir, constants.ProjectConfigFile)
+}
+
 func fileExists(path string) bool {
 	_, err := os.Stat(path)
 	return err == nil
@@ -219,6 +459,9 @@ type Provider struct {
 	IsLocal     bool   // For local models (Ollama, LM Studio)
 	IsGeneric   bool   // For generic OpenAI-compatible APIs
 	DefaultBase string // Default base URL for local/generic providers
+	HealthURL   string // Endpoint probed to show whether the API is reachable
+	// CheaperModel is offered when an analysis fails for lack of quota
+	CheaperModel string
 }
 
 // Model represents an LLM model
@@ -237,6 +480,7 @@ func GetProviders() []Provider {
 			Description: "Built-in LLM optimized for growth analysis",
 			RequiresKey: true,
 			AuthURL:     constants.SkeneAuthURL,
+			HealthURL:   constants.SkeneAuthURL,
 			Models: []Model{
 				{ID: "skene-growth-v1", Name: "skene-growth-v1", Description: "Growth analysis model"},
 			},
@@ -246,33 +490,39 @@ func GetProviders() []Provider {
 			Name:        "OpenAI",
 			Description: "GPT-4o and GPT-4 models",
 			RequiresKey: true,
+			HealthURL:   constants.OpenAIHealthURL,
 			Models: []Model{
 				{ID: "gpt-4o", Name: "gpt-4o", Description: "Most capable, multimodal"},
 				{ID: "gpt-4-turbo", Name: "gpt-4-turbo", Description: "Fast GPT-4 variant"},
 				{ID: "gpt-3.5-turbo", Name: "gpt-3.5-turbo", Description: "Fast and affordable"},
 			},
+			CheaperModel: "gpt-3.5-turbo",
 		},
 		{
 			ID:          "anthropic",
 			Name:        "Anthropic",
 			Description: "Claude models with strong reasoning",
 			RequiresKey: true,
+			HealthURL:   constants.AnthropicHealthURL,
 			Models: []Model{
 				{ID: "claude-opus-4-6", Name: "claude-opus-4-6", Description: "Most capable model for complex tasks"},
 				{ID: "claude-sonnet-4-5", Name: "claude-sonnet-4-5", Description: "Best combination of speed and intelligence"},
 				{ID: "claude-haiku-4-5", Name: "claude-haiku-4-5", Description: "Fastest model with near-frontier intelligence"},
 			},
+			CheaperModel: "claude-haiku-4-5",
 		},
 		{
 			ID:          "gemini",
 			Name:        "Gemini",
 			Description: "Google's Gemini models",
 			RequiresKey: true,
+			HealthURL:   constants.GeminiHealthURL,
 			Models: []Model{
 				{ID: "gemini-3-flash-preview", Name: "gemini-3-flash-preview", Description: "Fast and efficient"},
 				{ID: "gemini-3-pro-preview", Name: "gemini-3-pro-preview", Description: "Advanced capability"},
 				{ID: "gemini-2.5-flash", Name: "gemini-2.5-flash", Description: "Balanced performance"},
 			},
+			CheaperModel: "gemini-2.5-flash",
 		},
 		// TODO: re-enable local model providers after testing
 		// {
@@ -313,6 +563,113 @@ func GetProviders() []Provider {
 	}
 }
 
+// IdleTimeout returns how long an analysis may print nothing before it is
+// treated as stalled
+func (m *Manager) IdleTimeout() time.Duration {
+	if m.Config.IdleTimeout > 0 {
+		return time.Duration(m.Config.IdleTimeout) * time.Second
+	}
+	return constants.DefaultIdleTimeout
+}
+
+// AuthVerifyDelay returns how long the verifying screen stays up
+func (m *Manager) AuthVerifyDelay() time.Duration {
+	if m.Config.AuthVerifyDelay > 0 {
+		return time.Duration(m.Config.AuthVerifyDelay) * time.Millisecond
+	}
+	return constants.DefaultAuthVerifyDelay
+}
+
+// DashboardURL returns the Skene dashboard opened from the results screen
+func (m *Manager) DashboardURL() string {
+	if m.Config.DashboardURL != "" {
+		return m.Config.DashboardURL
+	}
+	return constants.SkeneDashboardURL
+}
+
+// AnalysisTimeout returns the deadline for a whole analysis run
+func (m *Manager) AnalysisTimeout() time.Duration {
+	if m.Config.AnalysisTimeout > 0 {
+		return time.Duration(m.Config.AnalysisTimeout) * time.Second
+	}
+	return constants.DefaultAnalysisTimeout
+}
+
+// GetModelByID returns a model of the given provider by ID, from the
+// built-in list or the cached live list
+func GetModelByID(providerID, modelID string) *Model {
+	p := GetProviderByID(providerID)
+	if p == nil {
+		return nil
+	}
+	for _, model := range p.Models {
+		if model.ID == modelID {
+			return &model
+		}
+	}
+	cached, _ := CachedModels(providerID)
+	for _, model := range cached {
+		if model.ID == modelID {
+			return &model
+		}
+	}
+	return nil
+}
+
+// ClosestModel returns the provider's listed model whose ID is nearest to
+// modelID by edit distance, for "did you mean" suggestions. It returns nil
+// for providers without a fixed model list.
+func ClosestModel(providerID, modelID string) *Model {
+	p := GetProviderByID(providerID)
+	if p == nil {
+		return nil
+	}
+	models := ProviderModels(p)
+	var best *Model
+	bestDist := -1
+	for i, model := range models {
+		if model.ID == modelID {
+			continue
+		}
+		if d := editDistance(strings.ToLower(model.ID), strings.ToLower(modelID)); bestDist < 0 || d < bestDist {
+			best, bestDist = &models[i], d
+		}
+	}
+	return best
+}
+
+// CheaperModel returns the provider's cheapest model to retry with after a
+// quota error, or nil when it has none or modelID already is it
+func CheaperModel(providerID, modelID string) *Model {
+	p := GetProviderByID(providerID)
+	if p == nil || p.CheaperModel == "" || p.CheaperModel == modelID {
+		return nil
+	}
+	return GetModelByID(providerID, p.CheaperModel)
+}
+
+// editDistance is the Levenshtein distance between a and b
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
 // GetProviderByID returns a provider by ID
 func GetProviderByID(id string) *Provider {
 	providers := GetProviders()
@@ -0,0 +1,118 @@
+package config
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"skene/internal/constants"
+)
+
+// modelCache holds model lists fetched from provider APIs, saved to
+// ~/.config/skene/models.json so they survive restarts
+type modelCache struct {
+	Providers map[string]cachedModels `json:"providers"`
+}
+
+type cachedModels struct {
+	FetchedAt time.Time     `json:"fetched_at"`
+	Models    []cachedModel `json:"models"`
+}
+
+type cachedModel struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+var (
+	modelCacheMu     sync.Mutex
+	modelCacheLoaded *modelCache
+)
+
+// modelCachePath returns ~/.config/skene/models.json, or "" without a home directory
+func modelCachePath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, constants.UserConfigDir, constants.ModelCacheFile)
+}
+
+// loadModelCache reads the cache file once. Callers hold modelCacheMu.
+func loadModelCache() *modelCache {
+	if modelCacheLoaded != nil {
+		return modelCacheLoaded
+	}
+	modelCacheLoaded = &modelCache{Providers: make(map[string]cachedModels)}
+	path := modelCachePath()
+	if path == "" {
+		return modelCacheLoaded
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return modelCacheLoaded
+	}
+	var cache modelCache
+	if json.Unmarshal(data, &cache) == nil && cache.Providers != nil {
+		modelCacheLoaded = &cache
+	}
+	return modelCacheLoaded
+}
+
+// CachedModels returns the live model list last fetched for a provider and
+// when it was fetched. Returns nil when it was never fetched.
+func CachedModels(providerID string) ([]Model, time.Time) {
+	modelCacheMu.Lock()
+	defer modelCacheMu.Unlock()
+
+	entry, ok := loadModelCache().Providers[providerID]
+	if !ok || len(entry.Models) == 0 {
+		return nil, time.Time{}
+	}
+	models := make([]Model, len(entry.Models))
+	for i, m := range entry.Models {
+		models[i] = Model{ID: m.ID, Name: m.Name, Description: m.Description}
+	}
+	return models, entry.FetchedAt
+}
+
+// SaveCachedModels records a provider's live model list
+func SaveCachedModels(providerID string, models []Model) error {
+	modelCacheMu.Lock()
+	defer modelCacheMu.Unlock()
+
+	cache := loadModelCache()
+	entry := cachedModels{FetchedAt: time.Now().UTC()}
+	for _, m := range models {
+		entry.Models = append(entry.Models, cachedModel{ID: m.ID, Name: m.Name, Description: m.Description})
+	}
+	cache.Providers[providerID] = entry
+
+	path := modelCachePath()
+	if path == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(cache, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// ProviderModels returns the models to offer for a provider: the cached
+// live list when one was fetched, otherwise the built-in list
+func ProviderModels(provider *Provider) []Model {
+	if provider == nil {
+		return nil
+	}
+	if models, _ := CachedModels(provider.ID); len(models) > 0 {
+		return models
+	}
+	return provider.Models
+}
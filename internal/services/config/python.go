@@ -0,0 +1,54 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"skene/internal/constants"
+)
+
+// PythonVersion is a Python release, compared on major and minor only
+type PythonVersion struct {
+	Major int
+	Minor int
+}
+
+// ParsePythonVersion reads "3.11", "3.12.4" or the output of
+// `python --version`, e.g. "Python 3.12.4"
+func ParsePythonVersion(s string) (PythonVersion, error) {
+	s = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(s), "Python"))
+	parts := strings.SplitN(s, ".", 3)
+	if len(parts) < 2 {
+		return PythonVersion{}, fmt.Errorf("%q is not a MAJOR.MINOR version", s)
+	}
+	major, err := strconv.Atoi(parts[0])
+	if err != nil || major < 0 {
+		return PythonVersion{}, fmt.Errorf("%q is not a MAJOR.MINOR version", s)
+	}
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil || minor < 0 {
+		return PythonVersion{}, fmt.Errorf("%q is not a MAJOR.MINOR version", s)
+	}
+	return PythonVersion{Major: major, Minor: minor}, nil
+}
+
+// String returns the version as MAJOR.MINOR
+func (v PythonVersion) String() string {
+	return fmt.Sprintf("%d.%d", v.Major, v.Minor)
+}
+
+// AtLeast reports whether v is min or a later release. A newer major
+// version is always later, whatever its minor version.
+func (v PythonVersion) AtLeast(min PythonVersion) bool {
+	return v.Major > min.Major || (v.Major == min.Major && v.Minor >= min.Minor)
+}
+
+// MinPython returns the lowest Python version skene-growth is run under
+func (m *Manager) MinPython() PythonVersion {
+	if v, err := ParsePythonVersion(m.Config.MinPython); err == nil {
+		return v
+	}
+	v, _ := ParsePythonVersion(constants.DefaultMinPython)
+	return v
+}
@@ -0,0 +1,25 @@
+package config
+
+import "testing"
+
+func TestPythonVersionAtLeast(t *testing.T) {
+	min := PythonVersion{Major: 3, Minor: 11}
+	tests := []struct {
+		version string
+		want    bool
+	}{
+		{"3.10", false},
+		{"3.11", true},
+		{"3.12", true},
+		{"4.0", true},
+	}
+	for _, tt := range tests {
+		v, err := ParsePythonVersion(tt.version)
+		if err != nil {
+			t.Fatalf("ParsePythonVersion(%q): %v", tt.version, err)
+		}
+		if got := v.AtLeast(min); got != tt.want {
+			t.Errorf("%s.AtLeast(%s) = %v, want %v", tt.version, min, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,202 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"skene/internal/constants"
+	"skene/internal/keymap"
+	"skene/internal/services/httpclient"
+)
+
+// LoadFile reads a single config file, JSON or YAML, and makes it the
+// active config. Unlike LoadConfig it rejects unknown keys, so typos are
+// reported instead of silently ignored.
+func (m *Manager) LoadFile(filePath string) error {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return err
+	}
+
+	config := defaultConfig()
+	format := DetectFormat(filePath, data)
+	if err := decodeConfig(format, data, config, true); err != nil {
+		return fmt.Errorf("invalid %s: %w", strings.ToUpper(string(format)), err)
+	}
+
+	m.Config = config
+	return nil
+}
+
+// Validate checks the loaded config for values that would fail at run time.
+// It returns one error per problem, or nil if the config is usable.
+func (m *Manager) Validate() []error {
+	cfg := m.Config
+	var errs []error
+	fail := func(format string, args ...interface{}) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+
+	provider := GetProviderByID(cfg.Provider)
+	switch {
+	case cfg.Provider == "":
+		fail("provider is not set")
+	case provider == nil:
+		fail("unknown provider %q", cfg.Provider)
+	}
+
+	if cfg.Model == "" {
+		fail("model is not set")
+	} else if provider != nil && !provider.IsLocal && !provider.IsGeneric && GetModelByID(cfg.Provider, cfg.Model) == nil {
+		fail("unknown model %q for provider %q", cfg.Model, cfg.Provider)
+	}
+
+	if provider != nil && !provider.IsLocal && cfg.APIKey == "" {
+		fail("api_key is required for provider %q", cfg.Provider)
+	}
+	if provider != nil && provider.IsGeneric && cfg.BaseURL == "" {
+		fail("base_url is required for provider %q", cfg.Provider)
+	}
+	if cfg.BaseURL != "" && !isHTTPURL(cfg.BaseURL) {
+		fail("base_url %q is not an http(s) URL", cfg.BaseURL)
+	}
+	if cfg.UpdateCheckURL != "" && !isHTTPURL(cfg.UpdateCheckURL) {
+		fail("update_check_url %q is not an http(s) URL", cfg.UpdateCheckURL)
+	}
+	if cfg.DashboardURL != "" && !isHTTPURL(cfg.DashboardURL) {
+		fail("dashboard_url %q is not an http(s) URL", cfg.DashboardURL)
+	}
+
+	if cfg.Proxy != "" {
+		if err := httpclient.ValidateProxyURL(cfg.Proxy); err != nil {
+			fail("proxy: %v", err)
+		}
+	}
+
+	if cfg.OutputDir == "" {
+		fail("output_dir must not be empty")
+	}
+
+	for _, folder := range cfg.ExcludeFolders {
+		if strings.TrimSpace(folder) == "" {
+			fail("exclude_folders contains an empty entry")
+		}
+	}
+
+	if cfg.IdleTimeout < 0 {
+		fail("idle_timeout must not be negative")
+	}
+	if cfg.AnalysisTimeout < 0 {
+		fail("analysis_timeout must not be negative")
+	}
+	if cfg.AuthVerifyDelay < 0 {
+		fail("auth_verify_delay_ms must not be negative")
+	} else if limit := constants.AuthVerifyTimeout.Milliseconds(); int64(cfg.AuthVerifyDelay) >= limit {
+		fail("auth_verify_delay_ms must be less than %d", limit)
+	}
+	if err := keymap.Check(cfg.KeyBindings, cfg.KeyMap); err != nil {
+		fail("%v", err)
+	}
+	if err := CheckSpinnerStyle(cfg.SpinnerStyle); err != nil {
+		fail("%v", err)
+	}
+	if err := ValidateOutputFiles(cfg.OutputFiles); err != nil {
+		fail("output_files: %v", err)
+	}
+	if cfg.MinPython != "" {
+		if _, err := ParsePythonVersion(cfg.MinPython); err != nil {
+			fail("min_python %q is not a MAJOR.MINOR version such as \"3.11\"", cfg.MinPython)
+		}
+	}
+	if cfg.IdleTimeout > 0 && cfg.AnalysisTimeout > 0 && cfg.IdleTimeout > cfg.AnalysisTimeout {
+		fail("idle_timeout (%ds) is longer than analysis_timeout (%ds)", cfg.IdleTimeout, cfg.AnalysisTimeout)
+	}
+
+	return errs
+}
+
+// ValidateOutputFiles checks that every key is a known artifact type and
+// every name is a plain file name that no other artifact uses
+func ValidateOutputFiles(files map[string]string) error {
+	artifacts := make([]string, 0, len(files))
+	for artifact := range files {
+		artifacts = append(artifacts, artifact)
+	}
+	sort.Strings(artifacts)
+
+	used := map[string]string{}
+	for artifact := range constants.DefaultOutputFiles {
+		if _, renamed := files[artifact]; !renamed {
+			used[constants.DefaultOutputFiles[artifact]] = artifact
+		}
+	}
+	for _, artifact := range artifacts {
+		name := files[artifact]
+		if _, ok := constants.DefaultOutputFiles[artifact]; !ok {
+			return fmt.Errorf("unknown artifact type %q", artifact)
+		}
+		if name == "" || name == "." || name == ".." || strings.ContainsAny(name, `/\`) {
+			return fmt.Errorf("%s: %q is not a plain file name", artifact, name)
+		}
+		if name == constants.ResultsJSONFile || name == constants.ScanCacheFile {
+			return fmt.Errorf("%s: %q is reserved", artifact, name)
+		}
+		if other, ok := used[name]; ok {
+			return fmt.Errorf("%s and %s both use %q", other, artifact, name)
+		}
+		used[name] = artifact
+	}
+	return nil
+}
+
+// CheckSpinnerStyle reports an unknown "spinner_style" name
+func CheckSpinnerStyle(name string) error {
+	if _, ok := constants.SpinnerStyles[name]; name != "" && !ok {
+		names := make([]string, 0, len(constants.SpinnerStyles))
+		for n := range constants.SpinnerStyles {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+		return fmt.Errorf("unknown spinner_style %q (choose from %s)", name, names)
+	}
+	return nil
+}
+
+func isHTTPURL(raw string) bool {
+	u, err := url.Parse(raw)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// ResolveAPIKey returns value unchanged unless it starts with "@", in which
+// case the key is read from the named file ("@~/.secrets/openai")
+func ResolveAPIKey(value string) (string, error) {
+	if !strings.HasPrefix(value, "@") {
+		return value, nil
+	}
+	return ReadAPIKeyFile(strings.TrimPrefix(value, "@"))
+}
+
+// ReadAPIKeyFile reads an API key from a file, expanding a leading ~ and
+// trimming surrounding whitespace
+func ReadAPIKeyFile(filePath string) (string, error) {
+	if filePath == "~" || strings.HasPrefix(filePath, "~/") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		filePath = filepath.Join(home, filePath[1:])
+	}
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return "", fmt.Errorf("could not read API key file: %w", err)
+	}
+	key := strings.TrimSpace(string(data))
+	if key == "" {
+		return "", fmt.Errorf("API key file %s is empty", filePath)
+	}
+	return key, nil
+}
@@ -0,0 +1,190 @@
+package gitclone
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"skene/internal/constants"
+)
+
+// Progress is a snapshot of a running clone
+type Progress struct {
+	Stage    string  // git's description, e.g. "Receiving objects"
+	Fraction float64 // overall progress, 0.0–1.0
+}
+
+var (
+	scpLikeRe  = regexp.MustCompile(`^[\w.-]+@[\w.-]+:[^/\\]`)
+	progressRe = regexp.MustCompile(`^(?:remote:\s*)?([A-Za-z ]+):\s+(\d+)%`)
+	unsafeRe   = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+)
+
+// remoteSchemes are the URL schemes git clones over the network
+var remoteSchemes = []string{"https://", "http://", "ssh://", "git://", "git+ssh://"}
+
+// IsRemoteURL reports whether project names a git repository rather than a
+// local path: a URL with a network scheme or an scp-like "git@host:org/repo"
+func IsRemoteURL(project string) bool {
+	lower := strings.ToLower(project)
+	for _, scheme := range remoteSchemes {
+		if strings.HasPrefix(lower, scheme) {
+			return true
+		}
+	}
+	return scpLikeRe.MatchString(project)
+}
+
+// RepoName returns the repository name from a clone URL, e.g. "repo" for
+// https://github.com/org/repo.git, safe to use in a file name
+func RepoName(url string) string {
+	name := strings.TrimSuffix(strings.TrimRight(url, "/"), ".git")
+	if i := strings.LastIndexAny(name, "/:"); i >= 0 {
+		name = name[i+1:]
+	}
+	name = strings.Trim(unsafeRe.ReplaceAllString(name, "-"), "-.")
+	if name == "" {
+		return "repo"
+	}
+	return name
+}
+
+// OutputDir resolves where results for a cloned repository are written.
+// The clone is temporary, so a relative output_dir is taken from the
+// working directory instead of the project, and the default is
+// skene-context/<repo>.
+func OutputDir(configured, url string) string {
+	if configured == "" {
+		configured = filepath.Join(constants.DefaultOutputDir, RepoName(url))
+	}
+	if filepath.IsAbs(configured) {
+		return configured
+	}
+	abs, err := filepath.Abs(configured)
+	if err != nil {
+		return configured
+	}
+	return abs
+}
+
+// Clone makes a shallow clone of url in a new temporary directory and
+// returns its path. Credentials come from the user's git setup (credential
+// helpers, SSH agent); git is never allowed to prompt, since the terminal
+// belongs to the UI. progress is called as git reports each stage. On
+// failure or cancellation the directory is removed.
+func Clone(ctx context.Context, url string, progress func(Progress)) (string, error) {
+	dir, err := os.MkdirTemp("", constants.CloneDirPrefix+RepoName(url)+"-")
+	if err != nil {
+		return "", fmt.Errorf("failed to create a directory for the clone: %w", err)
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "clone", "--depth", "1", "--progress", url, dir)
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	if os.Getenv("GIT_SSH_COMMAND") == "" {
+		cmd.Env = append(cmd.Env, "GIT_SSH_COMMAND=ssh -o BatchMode=yes")
+	}
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		os.RemoveAll(dir)
+		return "", err
+	}
+	if err := cmd.Start(); err != nil {
+		os.RemoveAll(dir)
+		return "", fmt.Errorf("failed to start git: %w", err)
+	}
+
+	// git redraws progress lines with \r, so split on either line ending
+	var messages []string
+	scanner := bufio.NewScanner(stderr)
+	scanner.Split(scanProgressLines)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		if p, ok := parseProgress(line); ok {
+			if progress != nil {
+				progress(p)
+			}
+			continue
+		}
+		messages = append(messages, line)
+	}
+
+	if err := cmd.Wait(); err != nil {
+		os.RemoveAll(dir)
+		if ctx.Err() != nil {
+			return "", ctx.Err()
+		}
+		return "", fmt.Errorf("git clone %s failed: %s", url, failureMessage(messages, err))
+	}
+	return dir, nil
+}
+
+// Remove deletes a clone made by Clone
+func Remove(dir string) error {
+	if dir == "" {
+		return nil
+	}
+	return os.RemoveAll(dir)
+}
+
+// stageWeights maps git's progress stages onto overall progress. Stages
+// report 0–100% each; earlier server-side stages are too quick to weigh.
+var stageWeights = map[string][2]float64{
+	"Receiving objects": {0.05, 0.85},
+	"Resolving deltas":  {0.85, 0.95},
+	"Updating files":    {0.95, 1.0},
+}
+
+// parseProgress reads lines like "Receiving objects:  45% (450/1000)"
+func parseProgress(line string) (Progress, bool) {
+	m := progressRe.FindStringSubmatch(line)
+	if m == nil {
+		return Progress{}, false
+	}
+	stage := strings.TrimSpace(m[1])
+	percent, _ := strconv.Atoi(m[2])
+	weight, ok := stageWeights[stage]
+	if !ok {
+		return Progress{Stage: stage, Fraction: 0.05 * float64(percent) / 100}, true
+	}
+	return Progress{Stage: stage, Fraction: weight[0] + (weight[1]-weight[0])*float64(percent)/100}, true
+}
+
+// failureMessage prefers git's "fatal:" lines, which name the actual
+// problem (not found, authentication failed), over the exit status
+func failureMessage(messages []string, err error) string {
+	var fatal []string
+	for _, line := range messages {
+		if strings.HasPrefix(line, "fatal:") || strings.HasPrefix(line, "ERROR:") {
+			fatal = append(fatal, line)
+		}
+	}
+	if len(fatal) > 0 {
+		return strings.Join(fatal, "; ")
+	}
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && len(messages) > 0 {
+		return messages[len(messages)-1]
+	}
+	return err.Error()
+}
+
+func scanProgressLines(data []byte, atEOF bool) (int, []byte, error) {
+	if i := bytes.IndexAny(data, "\r\n"); i >= 0 {
+		return i + 1, data[:i], nil
+	}
+	if atEOF && len(data) > 0 {
+		return len(data), data, nil
+	}
+	return 0, nil, nil
+}
@@ -0,0 +1,81 @@
+package growth
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"skene/internal/constants"
+)
+
+// archivePrevious copies the files of an existing analysis into
+// <outputDir>/archive/<timestamp>/ so a rerun cannot destroy them.
+// Returns the archive directory, or "" when there was nothing to back up.
+func archivePrevious(outputDir string, now time.Time) (string, error) {
+	entries, err := os.ReadDir(outputDir)
+	if os.IsNotExist(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	var files []string
+	for _, entry := range entries {
+		if entry.Type().IsRegular() {
+			files = append(files, entry.Name())
+		}
+	}
+	if len(files) == 0 {
+		return "", nil
+	}
+
+	archiveDir := filepath.Join(outputDir, constants.ArchiveDirName, now.Format("2006-01-02T150405"))
+	if err := os.MkdirAll(archiveDir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create archive directory: %w", err)
+	}
+
+	for _, name := range files {
+		if err := copyFile(filepath.Join(outputDir, name), filepath.Join(archiveDir, name)); err != nil {
+			return "", err
+		}
+	}
+
+	return archiveDir, nil
+}
+
+// CheckOutputWritable creates outputDir if needed and writes and removes a
+// temporary file in it, so a permissions problem is found before the
+// analysis runs rather than when its output is saved
+func CheckOutputWritable(outputDir string) error {
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return fmt.Errorf("cannot create output directory %s: %w", outputDir, err)
+	}
+	f, err := os.CreateTemp(outputDir, ".skene-write-check-*")
+	if err != nil {
+		return fmt.Errorf("cannot write to output directory %s: %w", outputDir, err)
+	}
+	name := f.Name()
+	f.Close()
+	return os.Remove(name)
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", src, err)
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", dst, err)
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return fmt.Errorf("failed to copy %s: %w", src, err)
+	}
+	return out.Close()
+}
@@ -0,0 +1,183 @@
+package growth
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"skene/internal/constants"
+)
+
+// DiffPrevious summarises what changed between an archived analysis and the
+// current result as Markdown: added and removed opportunities, added and
+// removed growth loops, changed loop priorities, and per-file line counts.
+// Returns "" when there is no previous analysis to compare against.
+func DiffPrevious(archiveDir string, names OutputNames, current *AnalysisResult) string {
+	if archiveDir == "" || current == nil {
+		return ""
+	}
+	previous := &AnalysisResult{
+		GrowthPlan:     loadFileContent(filepath.Join(archiveDir, names.File(constants.ArtifactGrowthPlan))),
+		Manifest:       loadFileContent(filepath.Join(archiveDir, names.File(constants.ArtifactGrowthManifest))),
+		GrowthTemplate: loadFileContent(filepath.Join(archiveDir, names.File(constants.ArtifactGrowthTemplate))),
+	}
+	if previous.GrowthPlan == "" && previous.Manifest == "" && previous.GrowthTemplate == "" {
+		return ""
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Changes since last run\n\nCompared with `%s`.\n", archiveDir)
+
+	added, removed := diffNames(opportunityNames(previous.Manifest), opportunityNames(current.Manifest))
+	b.WriteString("\n## Growth opportunities\n\n")
+	writeNameChanges(&b, added, removed)
+
+	oldLoops := ParseGrowthLoops(previous.GrowthPlan)
+	newLoops := ParseGrowthLoops(current.GrowthPlan)
+	added, removed = diffNames(loopNames(oldLoops), loopNames(newLoops))
+	b.WriteString("\n## Growth loops\n\n")
+	writeNameChanges(&b, added, removed)
+	for _, change := range priorityChanges(oldLoops, newLoops) {
+		b.WriteString("- Priority changed: " + change + "\n")
+	}
+
+	b.WriteString("\n## Files\n\n")
+	files := []struct{ name, old, new string }{
+		{names.File(constants.ArtifactGrowthManifest), previous.Manifest, current.Manifest},
+		{names.File(constants.ArtifactGrowthTemplate), previous.GrowthTemplate, current.GrowthTemplate},
+		{names.File(constants.ArtifactGrowthPlan), previous.GrowthPlan, current.GrowthPlan},
+	}
+	for _, f := range files {
+		plus, minus := lineDiff(f.old, f.new)
+		if plus == 0 && minus == 0 {
+			fmt.Fprintf(&b, "- `%s`: unchanged\n", f.name)
+		} else {
+			fmt.Fprintf(&b, "- `%s`: +%d / -%d lines\n", f.name, plus, minus)
+		}
+	}
+
+	return b.String()
+}
+
+func writeNameChanges(b *strings.Builder, added, removed []string) {
+	if len(added) == 0 && len(removed) == 0 {
+		b.WriteString("No changes.\n")
+		return
+	}
+	for _, name := range added {
+		b.WriteString("- Added: " + name + "\n")
+	}
+	for _, name := range removed {
+		b.WriteString("- Removed: " + name + "\n")
+	}
+}
+
+// opportunityNames lists the opportunities in a manifest. Entries may be
+// plain strings or objects with a name-like field.
+func opportunityNames(manifest string) []string {
+	var doc map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(manifest), &doc); err != nil {
+		return nil
+	}
+	var entries []json.RawMessage
+	for _, key := range []string{"growth_opportunities", "opportunities"} {
+		if raw, ok := doc[key]; ok {
+			json.Unmarshal(raw, &entries)
+			break
+		}
+	}
+
+	var names []string
+	for _, entry := range entries {
+		var s string
+		if json.Unmarshal(entry, &s) == nil {
+			names = append(names, s)
+			continue
+		}
+		var obj map[string]any
+		if json.Unmarshal(entry, &obj) != nil {
+			continue
+		}
+		for _, field := range []string{"name", "title", "feature_name", "opportunity"} {
+			if s, ok := obj[field].(string); ok && s != "" {
+				names = append(names, s)
+				break
+			}
+		}
+	}
+	return names
+}
+
+func loopNames(loops []GrowthLoop) []string {
+	names := make([]string, len(loops))
+	for i, loop := range loops {
+		names[i] = loop.Name
+	}
+	return names
+}
+
+// diffNames returns the names only in new and only in old, compared
+// case-insensitively and in their original order
+func diffNames(old, new []string) (added, removed []string) {
+	index := func(names []string) map[string]bool {
+		m := make(map[string]bool, len(names))
+		for _, n := range names {
+			m[strings.ToLower(strings.TrimSpace(n))] = true
+		}
+		return m
+	}
+	oldSet, newSet := index(old), index(new)
+	for _, n := range new {
+		if !oldSet[strings.ToLower(strings.TrimSpace(n))] {
+			added = append(added, n)
+		}
+	}
+	for _, n := range old {
+		if !newSet[strings.ToLower(strings.TrimSpace(n))] {
+			removed = append(removed, n)
+		}
+	}
+	return added, removed
+}
+
+// priorityChanges describes loops present in both runs whose priority differs
+func priorityChanges(old, new []GrowthLoop) []string {
+	previous := make(map[string]string, len(old))
+	for _, loop := range old {
+		previous[strings.ToLower(loop.Name)] = loop.Priority
+	}
+	var changes []string
+	for _, loop := range new {
+		before, ok := previous[strings.ToLower(loop.Name)]
+		if ok && before != "" && loop.Priority != "" && !strings.EqualFold(before, loop.Priority) {
+			changes = append(changes, fmt.Sprintf("%s: %s → %s", loop.Name, before, loop.Priority))
+		}
+	}
+	return changes
+}
+
+// lineDiff counts lines added and removed between two texts, treating each
+// as a multiset of lines so reordering alone does not count as a change
+func lineDiff(old, new string) (added, removed int) {
+	counts := make(map[string]int)
+	for _, line := range strings.Split(old, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			counts[line]++
+		}
+	}
+	for _, line := range strings.Split(new, "\n") {
+		if line = strings.TrimSpace(line); line == "" {
+			continue
+		}
+		if counts[line] > 0 {
+			counts[line]--
+		} else {
+			added++
+		}
+	}
+	for _, n := range counts {
+		removed += n
+	}
+	return added, removed
+}
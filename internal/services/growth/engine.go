@@ -7,10 +7,13 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
 	"skene/internal/constants"
+	"skene/internal/services/syscheck"
 	"skene/internal/services/uvresolver"
 )
 
@@ -46,6 +49,35 @@ func (p AnalysisPhase) String() string {
 	}
 }
 
+// runPhases returns the phases that run under config, in execution order
+func runPhases(config EngineConfig) []AnalysisPhase {
+	var phases []AnalysisPhase
+	for p := PhaseScanCodebase; p <= PhaseGenerateDocs; p++ {
+		if p == PhaseGenerateDocs && !config.GenerateDocs {
+			continue
+		}
+		phases = append(phases, p)
+	}
+	return phases
+}
+
+// FinalPhase returns the last phase that runs under config. Completion is
+// reported under it.
+func FinalPhase(config EngineConfig) AnalysisPhase {
+	phases := runPhases(config)
+	return phases[len(phases)-1]
+}
+
+// PhaseNames returns the display names of the phases that will run, in
+// execution order
+func PhaseNames(config EngineConfig) []string {
+	var names []string
+	for _, p := range runPhases(config) {
+		names = append(names, p.String())
+	}
+	return names
+}
+
 // PhaseUpdate is sent during analysis to update progress
 type PhaseUpdate struct {
 	Phase    AnalysisPhase
@@ -65,7 +97,15 @@ type AnalysisResult struct {
 	GrowthPlan     string
 	Manifest       string
 	GrowthTemplate string
+	TechStack      *TechStack
 	Error          error
+
+	// Changes is a Markdown summary of what differs from the archived
+	// previous run; empty when nothing was archived
+	Changes string
+
+	// JSON is the document written to results.json; nil when the run failed
+	JSON *AnalysisJSON
 }
 
 // EngineConfig holds the configuration passed to uvx commands
@@ -77,6 +117,42 @@ type EngineConfig struct {
 	ProjectDir string
 	OutputDir  string
 	UseGrowth bool
+
+	// BackupPrevious archives existing output files before analyze overwrites them
+	BackupPrevious bool
+
+	// IdleTimeout aborts a command that prints nothing for this long. It
+	// only starts once skene-growth prints its first line, so uv setting up
+	// Python and packages beforehand is not cut short. AnalysisTimeout
+	// bounds the whole analyze run. Zero disables either limit.
+	IdleTimeout     time.Duration
+	AnalysisTimeout time.Duration
+
+	// IncrementalScan compares file mtimes and sizes with the last run's
+	// .scan-cache.json and lists the changed paths in the analysis log
+	IncrementalScan bool
+
+	// OutputFiles renames the output files; skene-growth writes the default
+	// names, which are renamed once it exits, and every reader here looks
+	// them up under these names
+	OutputFiles OutputNames
+
+	// MinPython is passed to uvx as --python >=MinPython; empty lets uv choose
+	MinPython string
+
+	// Offline uses only a locally installed uvx and runs it with UV_OFFLINE
+	Offline bool
+
+	// Proxy is exported to uvx as HTTP_PROXY/HTTPS_PROXY; empty leaves the
+	// inherited environment alone
+	Proxy string
+
+	// GenerateDocs runs analyze with --product-docs and adds the docs phase
+	// to the phase list
+	GenerateDocs bool
+
+	// ExcludeFolders are folder names left out of the codebase scan
+	ExcludeFolders []string
 }
 
 // Engine spawns uvx commands to run Skene libraries in the selected repository
@@ -84,13 +160,27 @@ type Engine struct {
 	config   EngineConfig
 	updateFn func(PhaseUpdate)
 	promptFn func(InteractivePrompt)
+
+	// keepWaiting restarts the idle watchdog of the running command
+	keepWaiting chan struct{}
 }
 
 // NewEngine creates a new engine that delegates to uvx
 func NewEngine(config EngineConfig, updateFn func(PhaseUpdate)) *Engine {
 	return &Engine{
-		config:   config,
-		updateFn: updateFn,
+		config:      config,
+		updateFn:    updateFn,
+		keepWaiting: make(chan struct{}, 1),
+	}
+}
+
+// KeepWaiting gives a quiet provider another full idle timeout before the
+// running command is treated as stalled. It is safe to call from any
+// goroutine and does nothing when no command is waiting.
+func (e *Engine) KeepWaiting() {
+	select {
+	case e.keepWaiting <- struct{}{}:
+	default:
 	}
 }
 
@@ -105,66 +195,135 @@ func (e *Engine) Run(ctx context.Context) *AnalysisResult {
 
 	e.sendUpdate(PhaseScanCodebase, 0.0, "Starting analysis via uvx skene-growth...")
 
+	var archiveDir string
+	if e.config.BackupPrevious {
+		var err error
+		archiveDir, err = archivePrevious(e.resolveOutputDir(), time.Now())
+		if err != nil {
+			result.Error = fmt.Errorf("failed to back up previous analysis: %w", err)
+			return result
+		}
+		if archiveDir != "" {
+			e.sendUpdate(PhaseScanCodebase, 0.0, "Backed up previous analysis to "+archiveDir)
+		}
+	}
+
+	// uv cannot download a Python offline, so warn before it fails
+	if e.config.Offline && e.config.MinPython != "" {
+		if check := syscheck.CheckPython(ctx, e.config.MinPython); check.Status == syscheck.StatusFailed {
+			e.sendUpdate(PhaseScanCodebase, 0.0, "Warning: "+check.Message+". "+check.Detail)
+		}
+	}
+
+	result.TechStack = detectTechStack(e.config.ProjectDir)
+	if !result.TechStack.IsEmpty() {
+		e.sendUpdate(PhaseScanCodebase, 0.1, "Detected tech stack: "+result.TechStack.Summary())
+	}
+
+	var snapshot map[string]fileStamp
+	if e.config.IncrementalScan {
+		snapshot = e.prepareIncrementalScan()
+	}
+
 	args := []string{constants.GrowthPackageName, "analyze", "."}
 	args = append(args, e.buildCommonFlags()...)
+	args = append(args, e.buildAnalyzeFlags()...)
 
-	if err := e.runUVX(ctx, args); err != nil {
+	if e.config.AnalysisTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, e.config.AnalysisTimeout)
+		defer cancel()
+	}
+
+	err := e.runUVX(ctx, args)
+	if err == nil {
+		err = e.collectOutput()
+	}
+	if err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			msg := fmt.Sprintf("Analysis timed out after %s", e.config.AnalysisTimeout)
+			e.sendUpdate(PhaseScanCodebase, 0.0, msg)
+			result.Error = fmt.Errorf("analysis failed: timed out after %s", e.config.AnalysisTimeout)
+			return result
+		}
 		result.Error = fmt.Errorf("analysis failed: %w", err)
 		return result
 	}
 
-	e.sendUpdate(PhaseGenerateDocs, 1.0, "Analysis complete")
+	final := FinalPhase(e.config)
+	e.sendUpdate(final, 1.0, "Analysis complete")
 
 	outputDir := e.resolveOutputDir()
-	result.GrowthPlan = loadFileContent(filepath.Join(outputDir, constants.GrowthPlanFile))
-	result.Manifest = loadFileContent(filepath.Join(outputDir, constants.GrowthManifestFile))
-	result.GrowthTemplate = loadFileContent(filepath.Join(outputDir, constants.GrowthTemplateFile))
+	result.GrowthPlan = loadFileContent(filepath.Join(outputDir, e.outputFile(constants.ArtifactGrowthPlan)))
+	result.Manifest = loadFileContent(filepath.Join(outputDir, e.outputFile(constants.ArtifactGrowthManifest)))
+	result.GrowthTemplate = loadFileContent(filepath.Join(outputDir, e.outputFile(constants.ArtifactGrowthTemplate)))
+
+	results := BuildAnalysisJSON(e.config, result)
+	if result.Manifest == "" {
+		manifest, err := WriteManifestIfMissing(filepath.Join(outputDir, e.outputFile(constants.ArtifactGrowthManifest)), results)
+		if err != nil {
+			e.sendUpdate(final, 1.0, "Warning: "+err.Error())
+		} else if manifest != "" {
+			result.Manifest = manifest
+			results.Sections["growth_manifest"] = manifest
+		}
+	}
+	result.JSON = results
+	if err := WriteResultsJSON(outputDir, results); err != nil {
+		e.sendUpdate(final, 1.0, "Warning: "+err.Error())
+	}
+	result.Changes = DiffPrevious(archiveDir, e.config.OutputFiles, result)
+	if snapshot != nil {
+		if err := writeScanCache(scanCachePath(outputDir), snapshot); err != nil {
+			e.sendUpdate(final, 1.0, "Warning: "+err.Error())
+		}
+	}
 
 	return result
 }
 
 // GeneratePlan spawns uvx skene-growth plan
-func (e *Engine) GeneratePlan() *AnalysisResult {
+func (e *Engine) GeneratePlan(ctx context.Context) *AnalysisResult {
 	result := &AnalysisResult{}
 
 	args := []string{constants.GrowthPackageName, "plan"}
 	args = append(args, e.buildCommonFlags()...)
 
-	if err := e.runUVX(context.Background(), args); err != nil {
+	if err := e.runFollowUp(ctx, args); err != nil {
 		result.Error = fmt.Errorf("plan generation failed: %w", err)
 		return result
 	}
 
 	outputDir := e.resolveOutputDir()
-	result.GrowthPlan = loadFileContent(filepath.Join(outputDir, constants.GrowthPlanFile))
+	result.GrowthPlan = loadFileContent(filepath.Join(outputDir, e.outputFile(constants.ArtifactGrowthPlan)))
 	return result
 }
 
 // GenerateBuild spawns uvx skene-growth build
-func (e *Engine) GenerateBuild() *AnalysisResult {
+func (e *Engine) GenerateBuild(ctx context.Context) *AnalysisResult {
 	result := &AnalysisResult{}
 
 	args := []string{constants.GrowthPackageName, "build"}
 	args = append(args, e.buildCommonFlags()...)
 
-	if err := e.runUVX(context.Background(), args); err != nil {
+	if err := e.runFollowUp(ctx, args); err != nil {
 		result.Error = fmt.Errorf("build generation failed: %w", err)
 		return result
 	}
 
 	outputDir := e.resolveOutputDir()
-	result.GrowthPlan = loadFileContent(filepath.Join(outputDir, constants.ImplementationPromptFile))
+	result.GrowthPlan = loadFileContent(filepath.Join(outputDir, e.outputFile(constants.ArtifactImplementationPrompt)))
 	return result
 }
 
 // ValidateManifest spawns uvx skene-growth validate
-func (e *Engine) ValidateManifest() *AnalysisResult {
+func (e *Engine) ValidateManifest(ctx context.Context) *AnalysisResult {
 	result := &AnalysisResult{}
 
-	manifestPath := filepath.Join(e.resolveOutputDir(), constants.GrowthManifestFile)
+	manifestPath := filepath.Join(e.resolveOutputDir(), e.outputFile(constants.ArtifactGrowthManifest))
 	args := []string{constants.GrowthPackageName, "validate", manifestPath}
 
-	if err := e.runUVX(context.Background(), args); err != nil {
+	if err := e.runUVX(ctx, args); err != nil {
 		result.Error = fmt.Errorf("validation failed: %w", err)
 		return result
 	}
@@ -172,17 +331,43 @@ func (e *Engine) ValidateManifest() *AnalysisResult {
 	return result
 }
 
+// runFollowUp runs a command that builds on the last analysis, such as plan
+// or build. It sees the files under their default names and what it writes
+// is collected into the output directory.
+func (e *Engine) runFollowUp(ctx context.Context, args []string) error {
+	e.restoreDefaultNames()
+	err := e.runUVX(ctx, args)
+	// Collected even on failure, so renamed files get their names back
+	if collectErr := e.collectOutput(); err == nil {
+		err = collectErr
+	}
+	return err
+}
+
 // runUVX spawns a uvx command in the project directory and streams output.
 // It auto-provisions uv if not already installed.
 //
 // Uses chunk-based I/O so interactive prompts (no trailing newline) are
 // detected via a stall timer rather than waiting for a line delimiter.
 func (e *Engine) runUVX(ctx context.Context, args []string) error {
-	uvxPath, err := uvresolver.Resolve()
+	resolve := func() (string, error) {
+		return uvresolver.ResolveWithProgress(e.reportUVDownload())
+	}
+	if e.config.Offline {
+		resolve = uvresolver.ResolveLocal
+	}
+	uvxPath, err := resolve()
 	if err != nil {
 		return fmt.Errorf("failed to locate uvx: %w", err)
 	}
 
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	if e.config.MinPython != "" {
+		args = append([]string{"--python", ">=" + e.config.MinPython}, args...)
+	}
+
 	cmd := exec.CommandContext(ctx, uvxPath, args...)
 	cmd.Dir = e.config.ProjectDir
 	cmd.Env = append(os.Environ(), e.buildEnvVars()...)
@@ -201,6 +386,8 @@ func (e *Engine) runUVX(ctx context.Context, args []string) error {
 	if err := cmd.Start(); err != nil {
 		return fmt.Errorf("failed to start uvx: %w", err)
 	}
+	trackProcess(cmd)
+	defer untrackProcess(cmd)
 
 	type readResult struct {
 		line    string
@@ -301,12 +488,55 @@ func (e *Engine) runUVX(ctx context.Context, args []string) error {
 			return
 		}
 
+		if isRateLimitLine(trimmed) {
+			e.sendUpdate(PhaseDetectFeatures, 0.5, "Waiting for rate limit: "+trimmed)
+			appendLast(line)
+			return
+		}
+
+		if progress, ok := parseScanProgress(trimmed); ok {
+			e.sendUpdate(PhaseScanCodebase, progress, line)
+			appendLast(line)
+			return
+		}
+
 		e.sendUpdate(PhaseDetectFeatures, 0.5, line)
 		appendLast(line)
 	}
 
 	stallTimeout := 800 * time.Millisecond
 
+	// Watchdog for a provider that stalls without closing the connection.
+	// It is armed by the first line that is not uv's own, so a slow Python
+	// or package download never trips it.
+	var idleC <-chan time.Time
+	var idleTimer *time.Timer
+	defer func() {
+		if idleTimer != nil {
+			idleTimer.Stop()
+		}
+	}()
+	resetIdle := func(line string) {
+		if e.config.IdleTimeout <= 0 {
+			return
+		}
+		if idleTimer == nil {
+			if !isUVSetupLine(line) {
+				idleTimer = time.NewTimer(e.config.IdleTimeout)
+				idleC = idleTimer.C
+			}
+			return
+		}
+		if !idleTimer.Stop() {
+			select {
+			case <-idleTimer.C:
+			default:
+			}
+		}
+		idleTimer.Reset(e.config.IdleTimeout)
+	}
+	stalled := false
+
 	for {
 		var timer *time.Timer
 		if collectingOptions && len(pendingOptions) > 0 {
@@ -324,8 +554,21 @@ func (e *Engine) runUVX(ctx context.Context, args []string) error {
 					goto done
 				}
 				processLine(r.line)
+				resetIdle(r.line)
 			case <-timer.C:
 				firePrompt()
+				resetIdle("")
+			case <-e.keepWaiting:
+				timer.Stop()
+				if idleTimer != nil {
+					resetIdle("")
+				}
+			case <-idleC:
+				timer.Stop()
+				stalled = true
+				cancel()
+				stdin.Close()
+				goto done
 			case <-ctx.Done():
 				timer.Stop()
 				stdin.Close()
@@ -338,6 +581,16 @@ func (e *Engine) runUVX(ctx context.Context, args []string) error {
 					goto done
 				}
 				processLine(r.line)
+				resetIdle(r.line)
+			case <-e.keepWaiting:
+				if idleTimer != nil {
+					resetIdle("")
+				}
+			case <-idleC:
+				stalled = true
+				cancel()
+				stdin.Close()
+				goto done
 			case <-ctx.Done():
 				stdin.Close()
 				goto done
@@ -347,6 +600,11 @@ func (e *Engine) runUVX(ctx context.Context, args []string) error {
 
 done:
 	if err := cmd.Wait(); err != nil {
+		if stalled {
+			msg := fmt.Sprintf("No output for %s, the provider may have stalled", e.config.IdleTimeout)
+			e.sendUpdate(PhaseDetectFeatures, 0.5, msg)
+			return fmt.Errorf("timed out: no output for %s (idle_timeout)", e.config.IdleTimeout)
+		}
 		tail := strings.Join(lastLines, "\n")
 		if tail != "" {
 			return fmt.Errorf("uvx command failed:\n%s", tail)
@@ -356,6 +614,42 @@ done:
 	return nil
 }
 
+// uvSetupRe matches the lines uv prints while it provisions Python and the
+// skene-growth package, before skene-growth itself starts
+var uvSetupRe = regexp.MustCompile(`^(Resolved|Prepared|Installed|Uninstalled|Audited|Downloading|Downloaded|Building|Built|Updating|Updated|Using (CPython|Python))\b`)
+
+func isUVSetupLine(line string) bool {
+	return uvSetupRe.MatchString(strings.TrimSpace(line))
+}
+
+// scanProgressRe matches skene-growth's scan progress lines, such as
+// "Scanning codebase: 120/4000 files" or "Scanned 120 of 4000 files"
+var scanProgressRe = regexp.MustCompile(`(?i)\bscan\w*\b.*?\b(\d+)\s*(?:/|of)\s*(\d+)\s*files?\b`)
+
+// parseScanProgress maps a scan progress line to the scan phase's progress.
+// The first tenth of the phase is taken by tech stack detection.
+func parseScanProgress(line string) (float64, bool) {
+	m := scanProgressRe.FindStringSubmatch(line)
+	if m == nil {
+		return 0, false
+	}
+	done, _ := strconv.Atoi(m[1])
+	total, _ := strconv.Atoi(m[2])
+	if total <= 0 || done > total {
+		return 0, false
+	}
+	return 0.1 + 0.9*float64(done)/float64(total), true
+}
+
+// isRateLimitLine reports whether skene-growth is throttling or backing off
+// after a 429, so the analyzing view can say it is waiting
+func isRateLimitLine(line string) bool {
+	lower := strings.ToLower(line)
+	return strings.Contains(lower, "rate limit") ||
+		strings.Contains(lower, "ratelimit") ||
+		strings.Contains(lower, "429 too many requests")
+}
+
 func isPromptQuestion(line string) bool {
 	lower := strings.ToLower(line)
 	if strings.Contains(lower, "where do you want") ||
@@ -409,6 +703,18 @@ func (e *Engine) buildCommonFlags() []string {
 	return flags
 }
 
+// buildAnalyzeFlags returns the flags only the analyze command accepts
+func (e *Engine) buildAnalyzeFlags() []string {
+	var flags []string
+	if e.config.GenerateDocs {
+		flags = append(flags, "--product-docs")
+	}
+	for _, folder := range e.config.ExcludeFolders {
+		flags = append(flags, "--exclude", folder)
+	}
+	return flags
+}
+
 func (e *Engine) buildEnvVars() []string {
 	var envs []string
 	if e.config.APIKey != "" {
@@ -423,9 +729,53 @@ func (e *Engine) buildEnvVars() []string {
 	if e.config.BaseURL != "" {
 		envs = append(envs, "SKENE_BASE_URL="+e.config.BaseURL)
 	}
+	if e.config.Offline {
+		envs = append(envs, "UV_OFFLINE=1")
+	}
+	if e.config.Proxy != "" {
+		for _, name := range []string{"HTTP_PROXY", "HTTPS_PROXY", "http_proxy", "https_proxy"} {
+			envs = append(envs, name+"="+e.config.Proxy)
+		}
+	}
 	return envs
 }
 
+// prepareIncrementalScan snapshots the project and reports the files changed
+// since the last run. It returns the snapshot to save once the analysis
+// succeeds, or nil when the repository is too large to track.
+func (e *Engine) prepareIncrementalScan() map[string]fileStamp {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ScanCacheTimeout)
+	defer cancel()
+	snapshot, err := snapshotFiles(ctx, e.config.ProjectDir, e.config.ExcludeFolders, constants.LargeRepoFileThreshold)
+	if err != nil {
+		e.sendUpdate(PhaseScanCodebase, 0.1, "Incremental scan skipped: repository too large to track")
+		return nil
+	}
+
+	previous := loadScanCache(scanCachePath(e.resolveOutputDir()))
+	if previous == nil {
+		e.sendUpdate(PhaseScanCodebase, 0.1, "Incremental scan: no previous snapshot to compare with")
+		return snapshot
+	}
+
+	changed := changedFiles(previous.Files, snapshot)
+	if len(changed) == 0 {
+		e.sendUpdate(PhaseScanCodebase, 0.1, "Incremental scan: no files changed since the last run")
+		return snapshot
+	}
+
+	shown := changed
+	if len(shown) > 5 {
+		shown = shown[:5]
+	}
+	msg := fmt.Sprintf("Recently modified (%d): %s", len(changed), strings.Join(shown, ", "))
+	if len(changed) > len(shown) {
+		msg += ", ..."
+	}
+	e.sendUpdate(PhaseScanCodebase, 0.1, msg)
+	return snapshot
+}
+
 func (e *Engine) resolveOutputDir() string {
 	if e.config.OutputDir != "" {
 		if filepath.IsAbs(e.config.OutputDir) {
@@ -436,6 +786,118 @@ func (e *Engine) resolveOutputDir() string {
 	return filepath.Join(e.config.ProjectDir, constants.OutputDirName)
 }
 
+// generatedDir is where skene-growth writes its files: skene-context under
+// the directory it runs in
+func (e *Engine) generatedDir() string {
+	return filepath.Join(e.config.ProjectDir, constants.OutputDirName)
+}
+
+// collectOutput puts the files skene-growth wrote into the output directory
+// under their configured names. They are copied when the output directory
+// is somewhere else, such as outside a clone that is deleted on exit, and
+// renamed in place otherwise.
+func (e *Engine) collectOutput() error {
+	src, dst := e.generatedDir(), e.resolveOutputDir()
+	renames := e.config.OutputFiles.renames()
+	if filepath.Clean(src) == filepath.Clean(dst) {
+		for def, name := range renames {
+			if err := os.Rename(filepath.Join(src, def), filepath.Join(dst, name)); err != nil && !os.IsNotExist(err) {
+				return fmt.Errorf("failed to rename %s: %w", def, err)
+			}
+		}
+		return nil
+	}
+	entries, err := os.ReadDir(src)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", src, err)
+	}
+	if err := os.MkdirAll(dst, 0755); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+		name := entry.Name()
+		if renamed, ok := renames[name]; ok {
+			name = renamed
+		}
+		if err := copyFile(filepath.Join(src, entry.Name()), filepath.Join(dst, name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// restoreDefaultNames renames files renamed in place back to the names
+// skene-growth reads, before a command that builds on the last analysis
+func (e *Engine) restoreDefaultNames() {
+	dir := e.generatedDir()
+	if filepath.Clean(dir) != filepath.Clean(e.resolveOutputDir()) {
+		return
+	}
+	for def, name := range e.config.OutputFiles.renames() {
+		os.Rename(filepath.Join(dir, name), filepath.Join(dir, def))
+	}
+}
+
+// reportUVDownload returns a progress callback that shows the first-run uv
+// download as output lines, one per 10% so the log is not flooded
+func (e *Engine) reportUVDownload() uvresolver.ProgressFunc {
+	lastStep := int64(-1)
+	return func(done, total int64) {
+		const mb = 1024 * 1024
+		if total <= 0 {
+			// Unknown size: report every 5 MB instead
+			if step := done / (5 * mb); step != lastStep {
+				lastStep = step
+				e.sendUpdate(PhaseScanCodebase, 0.0, fmt.Sprintf("Downloading uv runtime... %.1f MB", float64(done)/mb))
+			}
+			return
+		}
+		if step := done * 10 / total; step != lastStep {
+			lastStep = step
+			msg := fmt.Sprintf("Downloading uv runtime... %d%% (%.1f / %.1f MB)", step*10, float64(done)/mb, float64(total)/mb)
+			if done >= total {
+				msg = "Downloaded uv runtime, unpacking..."
+			}
+			e.sendUpdate(PhaseScanCodebase, 0.0, msg)
+		}
+	}
+}
+
+// OutputFiles returns the absolute paths of the known output files that
+// exist in outputDir, in a stable order
+func OutputFiles(outputDir string, names OutputNames) []string {
+	abs, err := filepath.Abs(outputDir)
+	if err != nil {
+		abs = outputDir
+	}
+	var files []string
+	for _, name := range []string{
+		names.File(constants.ArtifactGrowthManifest),
+		names.File(constants.ArtifactGrowthTemplate),
+		names.File(constants.ArtifactGrowthPlan),
+		names.File(constants.ArtifactProductDocs),
+		names.File(constants.ArtifactImplementationPrompt),
+		constants.ResultsJSONFile,
+	} {
+		path := filepath.Join(abs, name)
+		if info, err := os.Stat(path); err == nil && !info.IsDir() {
+			files = append(files, path)
+		}
+	}
+	return files
+}
+
+// outputFile returns the configured file name for an artifact type
+func (e *Engine) outputFile(artifact string) string {
+	return e.config.OutputFiles.File(artifact)
+}
+
 func (e *Engine) sendUpdate(phase AnalysisPhase, progress float64, message string) {
 	if e.updateFn != nil {
 		e.updateFn(PhaseUpdate{
@@ -0,0 +1,29 @@
+package growth
+
+import (
+	"skene/internal/constants"
+)
+
+// OutputNames maps artifact types (constants.Artifact*) to the file names
+// they are written under. Types without an entry use the default name.
+type OutputNames map[string]string
+
+// File returns the file name for an artifact type
+func (n OutputNames) File(artifact string) string {
+	if name := n[artifact]; name != "" {
+		return name
+	}
+	return constants.DefaultOutputFiles[artifact]
+}
+
+// renames maps the default name skene-growth writes each renamed file
+// under to its configured name
+func (n OutputNames) renames() map[string]string {
+	renamed := map[string]string{}
+	for artifact, name := range n {
+		if def := constants.DefaultOutputFiles[artifact]; name != "" && name != def {
+			renamed[def] = name
+		}
+	}
+	return renamed
+}
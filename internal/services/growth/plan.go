@@ -0,0 +1,140 @@
+package growth
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// GrowthLoop is a single loop extracted from the growth plan
+type GrowthLoop struct {
+	Number   int      `json:"number"`
+	Name     string   `json:"name"`
+	Priority string   `json:"priority,omitempty"`
+	Impact   string   `json:"impact,omitempty"`
+	Actions  []string `json:"actions,omitempty"`
+}
+
+var (
+	loopHeadingRe = regexp.MustCompile(`(?i)^(#{2,4})\s*loop\s+(\d+)\s*[:.\-–—]\s*(.+)$`)
+	headingRe     = regexp.MustCompile(`^(#{1,6})\s+`)
+	fieldRe       = regexp.MustCompile(`(?i)^[-*\s]*\**\s*(priority|impact)\s*\**\s*:\s*\**\s*(.+?)\s*\**$`)
+	bulletRe      = regexp.MustCompile(`^\s*(?:[-*+]|\d+[.)])\s+(?:\[[ xX]\]\s+)?(.+)$`)
+)
+
+// ParseGrowthLoops extracts "### Loop N: Name" sections from a growth plan.
+// It returns nil when the plan does not follow the expected format.
+func ParseGrowthLoops(plan string) []GrowthLoop {
+	var loops []GrowthLoop
+	var current *GrowthLoop
+	level := 0
+
+	flush := func() {
+		if current != nil {
+			loops = append(loops, *current)
+			current = nil
+		}
+	}
+
+	for _, raw := range strings.Split(plan, "\n") {
+		line := strings.TrimRight(raw, "\r")
+		trimmed := strings.TrimSpace(line)
+
+		if m := loopHeadingRe.FindStringSubmatch(trimmed); m != nil {
+			flush()
+			level = len(m[1])
+			number, _ := strconv.Atoi(m[2])
+			current = &GrowthLoop{
+				Number: number,
+				Name:   strings.Trim(strings.TrimSpace(m[3]), "*"),
+			}
+			continue
+		}
+
+		if current == nil {
+			continue
+		}
+
+		// A heading at the same or a higher level closes the loop
+		if m := headingRe.FindStringSubmatch(trimmed); m != nil && len(m[1]) <= level {
+			flush()
+			continue
+		}
+
+		if m := fieldRe.FindStringSubmatch(trimmed); m != nil {
+			switch strings.ToLower(m[1]) {
+			case "priority":
+				current.Priority = m[2]
+			case "impact":
+				current.Impact = m[2]
+			}
+			continue
+		}
+
+		if m := bulletRe.FindStringSubmatch(line); m != nil {
+			current.Actions = append(current.Actions, strings.TrimSpace(m[1]))
+		}
+	}
+	flush()
+
+	return loops
+}
+
+// Heading is a Markdown section title, used to navigate long documents
+type Heading struct {
+	Level int
+	Title string
+}
+
+var setextRe = regexp.MustCompile(`^(=+|-+)\s*$`)
+
+// ParseHeadings lists the ATX ("## Title") and setext ("Title" underlined
+// with === or ---) headings of a Markdown document in order, skipping
+// fenced code blocks. Inline emphasis and code markers are removed.
+func ParseHeadings(markdown string) []Heading {
+	var headings []Heading
+	inFence := false
+	previous := ""
+
+	for _, raw := range strings.Split(markdown, "\n") {
+		trimmed := strings.TrimSpace(strings.TrimRight(raw, "\r"))
+
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			previous = ""
+			continue
+		}
+		if inFence {
+			continue
+		}
+
+		if m := headingRe.FindStringSubmatch(trimmed); m != nil {
+			title := strings.TrimRight(strings.TrimSpace(trimmed[len(m[0]):]), "# ")
+			if title = cleanHeading(title); title != "" {
+				headings = append(headings, Heading{Level: len(m[1]), Title: title})
+			}
+			previous = ""
+			continue
+		}
+
+		if m := setextRe.FindStringSubmatch(trimmed); m != nil && previous != "" && bulletRe.FindString(previous) == "" {
+			level := 1
+			if m[1][0] == '-' {
+				level = 2
+			}
+			if title := cleanHeading(previous); title != "" {
+				headings = append(headings, Heading{Level: level, Title: title})
+			}
+			previous = ""
+			continue
+		}
+
+		previous = trimmed
+	}
+
+	return headings
+}
+
+func cleanHeading(title string) string {
+	return strings.TrimSpace(strings.NewReplacer("**", "", "__", "", "`", "").Replace(title))
+}
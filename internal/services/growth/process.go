@@ -0,0 +1,38 @@
+package growth
+
+import (
+	"os/exec"
+	"sync"
+)
+
+// Running uvx subprocesses, so they can be killed when the CLI exits
+// without waiting for their contexts to be cancelled
+var (
+	runningMu sync.Mutex
+	running   = map[*exec.Cmd]struct{}{}
+)
+
+func trackProcess(cmd *exec.Cmd) {
+	runningMu.Lock()
+	running[cmd] = struct{}{}
+	runningMu.Unlock()
+}
+
+func untrackProcess(cmd *exec.Cmd) {
+	runningMu.Lock()
+	delete(running, cmd)
+	runningMu.Unlock()
+}
+
+// KillRunning kills every uvx subprocess that is still running. It is
+// called on exit so an abrupt quit does not leave children behind.
+func KillRunning() {
+	runningMu.Lock()
+	defer runningMu.Unlock()
+	for cmd := range running {
+		if cmd.Process != nil {
+			cmd.Process.Kill()
+		}
+		delete(running, cmd)
+	}
+}
@@ -0,0 +1,54 @@
+package growth
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"skene/internal/constants"
+)
+
+// Section identifies one output file that can be regenerated on its own.
+// Values match the keys of AnalysisJSON.Sections and the artifact types.
+type Section string
+
+const (
+	SectionManifest Section = "growth_manifest"
+	SectionTemplate Section = "growth_template"
+	SectionPlan     Section = "growth_plan"
+)
+
+// File returns the output file the section is written to
+func (s Section) File(names OutputNames) string {
+	return names.File(string(s))
+}
+
+// RegenerateSection reruns only the step that produces one output file.
+// Only the growth plan has a subcommand of its own ("plan", which reads the
+// previous manifest); the manifest and template come out of a full analyze.
+func (e *Engine) RegenerateSection(ctx context.Context, section Section) *AnalysisResult {
+	result := &AnalysisResult{}
+
+	outputDir := e.resolveOutputDir()
+	manifestPath := filepath.Join(outputDir, e.outputFile(constants.ArtifactGrowthManifest))
+	if _, err := os.Stat(manifestPath); err != nil {
+		result.Error = fmt.Errorf("no previous analysis found in %s; run a full analysis first", outputDir)
+		return result
+	}
+
+	if section != SectionPlan {
+		result.Error = fmt.Errorf("%s cannot be regenerated on its own; run a full analysis", section.File(e.config.OutputFiles))
+		return result
+	}
+	args := []string{constants.GrowthPackageName, "plan"}
+	args = append(args, e.buildCommonFlags()...)
+
+	if err := e.runFollowUp(ctx, args); err != nil {
+		result.Error = fmt.Errorf("regenerating %s failed: %w", section.File(e.config.OutputFiles), err)
+		return result
+	}
+
+	result.GrowthPlan = loadFileContent(filepath.Join(outputDir, section.File(e.config.OutputFiles)))
+	return result
+}
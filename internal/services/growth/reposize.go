@@ -0,0 +1,73 @@
+package growth
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"path/filepath"
+
+	"skene/internal/constants"
+)
+
+// RepoSize is a bounded count of the files an analysis would scan
+type RepoSize struct {
+	Files int
+	Bytes int64
+	// Truncated is set when the count stopped at the limit or the deadline,
+	// so Files is a lower bound
+	Truncated bool
+}
+
+var errScanLimit = errors.New("scan limit reached")
+
+// alwaysSkipped are directories that are never part of an analysis
+var alwaysSkipped = map[string]bool{
+	".git":                  true,
+	constants.OutputDirName: true,
+	"node_modules":          true,
+	".venv":                 true,
+	"__pycache__":           true,
+}
+
+// CountRepoFiles walks projectDir and counts regular files, skipping the
+// excluded folder names. It stops after limit files or when ctx is done.
+func CountRepoFiles(ctx context.Context, projectDir string, excludeFolders []string, limit int) RepoSize {
+	excluded := make(map[string]bool, len(excludeFolders))
+	for _, name := range excludeFolders {
+		excluded[name] = true
+	}
+
+	var size RepoSize
+	err := filepath.WalkDir(projectDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// Unreadable entries are not scanned either
+			if d != nil && d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() {
+			if path != projectDir && (alwaysSkipped[d.Name()] || excluded[d.Name()]) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		size.Files++
+		if info, err := d.Info(); err == nil {
+			size.Bytes += info.Size()
+		}
+		if size.Files >= limit {
+			return errScanLimit
+		}
+		if size.Files%1000 == 0 && ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return nil
+	})
+	size.Truncated = err != nil
+	return size
+}
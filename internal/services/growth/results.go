@@ -0,0 +1,158 @@
+package growth
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"skene/internal/constants"
+)
+
+// AnalysisJSONVersion is bumped whenever AnalysisJSON changes incompatibly
+const AnalysisJSONVersion = 1
+
+// AnalysisJSON is the stable, machine-readable form of an analysis written
+// to results.json and printed by --json. Fields are only ever added.
+type AnalysisJSON struct {
+	SchemaVersion int               `json:"schema_version"`
+	GeneratedAt   string            `json:"generated_at"`
+	Provider      string            `json:"provider,omitempty"`
+	Model         string            `json:"model,omitempty"`
+	ProjectDir    string            `json:"project_dir"`
+	TechStack     json.RawMessage   `json:"tech_stack,omitempty"`
+	Opportunities json.RawMessage   `json:"opportunities,omitempty"`
+	GrowthLoops   []GrowthLoop      `json:"growth_loops"`
+	Sections      map[string]string `json:"sections"`
+
+	DetectedTechStack *TechStack `json:"detected_tech_stack,omitempty"`
+}
+
+// BuildAnalysisJSON assembles the structured result from the raw output files.
+// Tech stack and opportunities are lifted from the manifest when it is valid JSON.
+func BuildAnalysisJSON(config EngineConfig, result *AnalysisResult) *AnalysisJSON {
+	out := &AnalysisJSON{
+		SchemaVersion:     AnalysisJSONVersion,
+		GeneratedAt:       time.Now().UTC().Format(time.RFC3339),
+		Provider:          config.Provider,
+		Model:             config.Model,
+		ProjectDir:        config.ProjectDir,
+		GrowthLoops:       ParseGrowthLoops(result.GrowthPlan),
+		DetectedTechStack: result.TechStack,
+		Sections: map[string]string{
+			"growth_plan":     result.GrowthPlan,
+			"growth_manifest": result.Manifest,
+			"growth_template": result.GrowthTemplate,
+		},
+	}
+	if out.GrowthLoops == nil {
+		out.GrowthLoops = []GrowthLoop{}
+	}
+
+	var manifest map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(result.Manifest), &manifest); err == nil {
+		out.TechStack = manifest["tech_stack"]
+		for _, key := range []string{"growth_opportunities", "opportunities"} {
+			if raw, ok := manifest[key]; ok {
+				out.Opportunities = raw
+				break
+			}
+		}
+	}
+
+	return out
+}
+
+// ManifestSummary is the at-a-glance verdict shown above the results tabs
+type ManifestSummary struct {
+	HighPriority int
+	TechStack    *TechStack
+}
+
+// SummarizeManifest counts high-priority opportunities and reads the
+// deterministic tech stack from a growth manifest. Invalid JSON yields an
+// empty summary.
+func SummarizeManifest(manifest string) ManifestSummary {
+	var summary ManifestSummary
+	var doc map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(manifest), &doc); err != nil {
+		return summary
+	}
+
+	for _, key := range []string{"growth_opportunities", "opportunities"} {
+		var entries []map[string]any
+		if raw, ok := doc[key]; ok {
+			json.Unmarshal(raw, &entries)
+			for _, entry := range entries {
+				if priority, _ := entry["priority"].(string); strings.EqualFold(priority, "high") {
+					summary.HighPriority++
+				}
+			}
+			break
+		}
+	}
+
+	if raw, ok := doc["detected_tech_stack"]; ok {
+		var stack TechStack
+		if json.Unmarshal(raw, &stack) == nil {
+			summary.TechStack = &stack
+		}
+	}
+	return summary
+}
+
+// WriteResultsJSON writes results.json into the output directory
+func WriteResultsJSON(outputDir string, results *AnalysisJSON) error {
+	data, err := json.MarshalIndent(results, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal results: %w", err)
+	}
+
+	path := filepath.Join(outputDir, constants.ResultsJSONFile)
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
+
+// GrowthManifestJSON is the fallback growth-manifest.json written when the
+// skene-growth run did not produce one, so validate and build have an input.
+type GrowthManifestJSON struct {
+	SchemaVersion int             `json:"schema_version"`
+	GeneratedBy   string          `json:"generated_by"`
+	GeneratedAt   string          `json:"generated_at"`
+	ProjectDir    string          `json:"project_dir"`
+	TechStack     json.RawMessage `json:"tech_stack,omitempty"`
+	Opportunities json.RawMessage `json:"growth_opportunities,omitempty"`
+	GrowthLoops   []GrowthLoop    `json:"growth_loops"`
+}
+
+// WriteManifestIfMissing writes the manifest at path from the structured
+// results unless the file already exists. Returns the manifest content that
+// was written, or "" when an existing file was kept.
+func WriteManifestIfMissing(path string, results *AnalysisJSON) (string, error) {
+	if _, err := os.Stat(path); err == nil {
+		return "", nil
+	}
+
+	manifest := GrowthManifestJSON{
+		SchemaVersion: AnalysisJSONVersion,
+		GeneratedBy:   "skene-cli " + constants.Version,
+		GeneratedAt:   results.GeneratedAt,
+		ProjectDir:    results.ProjectDir,
+		TechStack:     results.TechStack,
+		Opportunities: results.Opportunities,
+		GrowthLoops:   results.GrowthLoops,
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal manifest: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return "", fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return string(data), nil
+}
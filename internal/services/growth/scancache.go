@@ -0,0 +1,129 @@
+package growth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"skene/internal/constants"
+)
+
+// scanCache records the files seen by the last incremental scan, written to
+// <outputDir>/.scan-cache.json
+type scanCache struct {
+	Version     int                  `json:"version"`
+	GeneratedAt string               `json:"generated_at"`
+	Files       map[string]fileStamp `json:"files"`
+}
+
+// fileStamp identifies a file's content cheaply: a changed size or mtime
+// means the file was modified
+type fileStamp struct {
+	ModTime int64 `json:"mtime"`
+	Size    int64 `json:"size"`
+}
+
+const scanCacheVersion = 1
+
+// snapshotFiles stamps every file an analysis would scan, keyed by its
+// slash-separated path relative to projectDir. It fails rather than return
+// a partial snapshot when the repository exceeds limit files or ctx is done.
+func snapshotFiles(ctx context.Context, projectDir string, excludeFolders []string, limit int) (map[string]fileStamp, error) {
+	excluded := make(map[string]bool, len(excludeFolders))
+	for _, name := range excludeFolders {
+		excluded[name] = true
+	}
+
+	files := make(map[string]fileStamp)
+	err := filepath.WalkDir(projectDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if d != nil && d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() {
+			if path != projectDir && (alwaysSkipped[d.Name()] || excluded[d.Name()]) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return nil
+		}
+		rel, err := filepath.Rel(projectDir, path)
+		if err != nil {
+			return nil
+		}
+		files[filepath.ToSlash(rel)] = fileStamp{ModTime: info.ModTime().UnixNano(), Size: info.Size()}
+		if len(files) >= limit {
+			return errScanLimit
+		}
+		if len(files)%1000 == 0 && ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// loadScanCache reads the previous snapshot; nil when there is none or it
+// was written by an incompatible version
+func loadScanCache(path string) *scanCache {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	var cache scanCache
+	if err := json.Unmarshal(data, &cache); err != nil || cache.Version != scanCacheVersion {
+		return nil
+	}
+	return &cache
+}
+
+func writeScanCache(path string, files map[string]fileStamp) error {
+	data, err := json.MarshalIndent(scanCache{
+		Version:     scanCacheVersion,
+		GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+		Files:       files,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write scan cache: %w", err)
+	}
+	return nil
+}
+
+// changedFiles lists files that are new or modified since previous, sorted
+func changedFiles(previous, current map[string]fileStamp) []string {
+	var changed []string
+	for path, stamp := range current {
+		if old, ok := previous[path]; !ok || old != stamp {
+			changed = append(changed, path)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
+
+// scanCachePath is where the incremental scan snapshot lives
+func scanCachePath(outputDir string) string {
+	return filepath.Join(outputDir, constants.ScanCacheFile)
+}
@@ -0,0 +1,332 @@
+package growth
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// TechStack is the deterministic stack detected from manifests and lockfiles.
+// It is stored verbatim in the manifest under "detected_tech_stack".
+type TechStack struct {
+	Languages  []string `json:"languages,omitempty"`
+	Frameworks []string `json:"frameworks,omitempty"`
+	Databases  []string `json:"databases,omitempty"`
+	Deployment []string `json:"deployment,omitempty"`
+	Sources    []string `json:"sources"`
+}
+
+// IsEmpty reports whether nothing was detected
+func (t *TechStack) IsEmpty() bool {
+	return t == nil || len(t.Sources) == 0
+}
+
+// Summary returns a short comma-separated list of everything detected
+func (t *TechStack) Summary() string {
+	var parts []string
+	parts = append(parts, t.Languages...)
+	parts = append(parts, t.Frameworks...)
+	parts = append(parts, t.Databases...)
+	parts = append(parts, t.Deployment...)
+	return strings.Join(parts, ", ")
+}
+
+type stackMatch struct {
+	framework string
+	database  string
+}
+
+// Dependency names mapped to what they reveal, per ecosystem
+var (
+	npmStack = map[string]stackMatch{
+		"next":                  {framework: "Next.js"},
+		"react":                 {framework: "React"},
+		"vue":                   {framework: "Vue"},
+		"nuxt":                  {framework: "Nuxt"},
+		"svelte":                {framework: "Svelte"},
+		"@sveltejs/kit":         {framework: "SvelteKit"},
+		"@angular/core":         {framework: "Angular"},
+		"@remix-run/react":      {framework: "Remix"},
+		"express":               {framework: "Express"},
+		"fastify":               {framework: "Fastify"},
+		"@nestjs/core":          {framework: "NestJS"},
+		"pg":                    {database: "PostgreSQL"},
+		"postgres":              {database: "PostgreSQL"},
+		"mysql":                 {database: "MySQL"},
+		"mysql2":                {database: "MySQL"},
+		"mongodb":               {database: "MongoDB"},
+		"mongoose":              {database: "MongoDB"},
+		"redis":                 {database: "Redis"},
+		"ioredis":               {database: "Redis"},
+		"sqlite3":               {database: "SQLite"},
+		"better-sqlite3":        {database: "SQLite"},
+		"@prisma/client":        {database: "Prisma"},
+		"drizzle-orm":           {database: "Drizzle"},
+		"@supabase/supabase-js": {database: "Supabase"},
+		"firebase":              {database: "Firebase"},
+	}
+
+	pythonStack = map[string]stackMatch{
+		"django":          {framework: "Django"},
+		"flask":           {framework: "Flask"},
+		"fastapi":         {framework: "FastAPI"},
+		"starlette":       {framework: "Starlette"},
+		"psycopg":         {database: "PostgreSQL"},
+		"psycopg2":        {database: "PostgreSQL"},
+		"psycopg2-binary": {database: "PostgreSQL"},
+		"asyncpg":         {database: "PostgreSQL"},
+		"pymysql":         {database: "MySQL"},
+		"mysqlclient":     {database: "MySQL"},
+		"pymongo":         {database: "MongoDB"},
+		"redis":           {database: "Redis"},
+		"sqlalchemy":      {database: "SQLAlchemy"},
+		"supabase":        {database: "Supabase"},
+	}
+
+	goStack = map[string]stackMatch{
+		"github.com/gin-gonic/gin":           {framework: "Gin"},
+		"github.com/labstack/echo":           {framework: "Echo"},
+		"github.com/gofiber/fiber":           {framework: "Fiber"},
+		"github.com/go-chi/chi":              {framework: "Chi"},
+		"github.com/charmbracelet/bubbletea": {framework: "Bubble Tea"},
+		"github.com/jackc/pgx":               {database: "PostgreSQL"},
+		"github.com/lib/pq":                  {database: "PostgreSQL"},
+		"github.com/go-sql-driver/mysql":     {database: "MySQL"},
+		"go.mongodb.org/mongo-driver":        {database: "MongoDB"},
+		"github.com/redis/go-redis":          {database: "Redis"},
+		"github.com/go-redis/redis":          {database: "Redis"},
+		"github.com/mattn/go-sqlite3":        {database: "SQLite"},
+		"gorm.io/gorm":                       {database: "GORM"},
+	}
+
+	rustStack = map[string]stackMatch{
+		"actix-web":      {framework: "Actix Web"},
+		"axum":           {framework: "Axum"},
+		"rocket":         {framework: "Rocket"},
+		"tokio-postgres": {database: "PostgreSQL"},
+		"sqlx":           {database: "SQLx"},
+		"diesel":         {database: "Diesel"},
+		"mongodb":        {database: "MongoDB"},
+		"redis":          {database: "Redis"},
+		"rusqlite":       {database: "SQLite"},
+	}
+
+	// Files whose presence alone identifies a deploy target
+	deployMarkers = map[string]string{
+		"vercel.json":        "Vercel",
+		"netlify.toml":       "Netlify",
+		"fly.toml":           "Fly.io",
+		"Procfile":           "Heroku",
+		"render.yaml":        "Render",
+		"app.yaml":           "Google App Engine",
+		"serverless.yml":     "Serverless Framework",
+		"docker-compose.yml": "Docker Compose",
+		"compose.yaml":       "Docker Compose",
+	}
+
+	// Docker images that are databases rather than runtimes
+	imageDatabases = map[string]string{
+		"postgres": "PostgreSQL",
+		"mysql":    "MySQL",
+		"mariadb":  "MariaDB",
+		"mongo":    "MongoDB",
+		"redis":    "Redis",
+	}
+
+	pyRequirementRe = regexp.MustCompile(`^\s*"?([A-Za-z0-9][A-Za-z0-9._\-]*)`)
+	fromRe          = regexp.MustCompile(`(?i)^\s*FROM\s+(?:--platform=\S+\s+)?(\S+)`)
+	imageRe         = regexp.MustCompile(`^\s*image:\s*["']?([^"'\s]+)`)
+)
+
+// detectTechStack inspects well-known manifests in the project root. It only
+// reports what the files state, so it never guesses.
+func detectTechStack(projectDir string) *TechStack {
+	d := &stackDetector{root: projectDir, seen: map[string]bool{}, stack: &TechStack{}}
+
+	d.packageJSON()
+	d.python()
+	d.goMod()
+	d.cargo()
+	d.docker()
+	for file, target := range deployMarkers {
+		if d.exists(file) {
+			d.add(&d.stack.Deployment, target)
+			d.source(file)
+		}
+	}
+
+	sort.Strings(d.stack.Deployment)
+	sort.Strings(d.stack.Sources)
+	return d.stack
+}
+
+type stackDetector struct {
+	root  string
+	seen  map[string]bool
+	stack *TechStack
+}
+
+func (d *stackDetector) exists(name string) bool {
+	_, err := os.Stat(filepath.Join(d.root, name))
+	return err == nil
+}
+
+func (d *stackDetector) read(name string) (string, bool) {
+	data, err := os.ReadFile(filepath.Join(d.root, name))
+	if err != nil {
+		return "", false
+	}
+	return string(data), true
+}
+
+func (d *stackDetector) source(name string) {
+	if !d.seen["source:"+name] {
+		d.seen["source:"+name] = true
+		d.stack.Sources = append(d.stack.Sources, name)
+	}
+}
+
+func (d *stackDetector) add(list *[]string, value string) {
+	if value != "" && !d.seen[value] {
+		d.seen[value] = true
+		*list = append(*list, value)
+	}
+}
+
+func (d *stackDetector) match(table map[string]stackMatch, dep string) {
+	if m, ok := table[dep]; ok {
+		d.add(&d.stack.Frameworks, m.framework)
+		d.add(&d.stack.Databases, m.database)
+	}
+}
+
+func (d *stackDetector) packageJSON() {
+	content, ok := d.read("package.json")
+	if !ok {
+		return
+	}
+	d.source("package.json")
+
+	var pkg struct {
+		Dependencies    map[string]string `json:"dependencies"`
+		DevDependencies map[string]string `json:"devDependencies"`
+	}
+	json.Unmarshal([]byte(content), &pkg)
+
+	_, hasTS := pkg.DevDependencies["typescript"]
+	if _, ok := pkg.Dependencies["typescript"]; ok || d.exists("tsconfig.json") {
+		hasTS = true
+	}
+	if hasTS {
+		d.add(&d.stack.Languages, "TypeScript")
+	} else {
+		d.add(&d.stack.Languages, "JavaScript")
+	}
+
+	for _, deps := range []map[string]string{pkg.Dependencies, pkg.DevDependencies} {
+		for _, name := range sortedKeys(deps) {
+			d.match(npmStack, name)
+		}
+	}
+}
+
+func (d *stackDetector) python() {
+	for _, file := range []string{"pyproject.toml", "requirements.txt", "Pipfile"} {
+		content, ok := d.read(file)
+		if !ok {
+			continue
+		}
+		d.source(file)
+		d.add(&d.stack.Languages, "Python")
+
+		scanner := bufio.NewScanner(strings.NewReader(content))
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if m := pyRequirementRe.FindStringSubmatch(line); m != nil {
+				d.match(pythonStack, strings.ToLower(m[1]))
+			}
+		}
+	}
+}
+
+func (d *stackDetector) goMod() {
+	content, ok := d.read("go.mod")
+	if !ok {
+		return
+	}
+	d.source("go.mod")
+	d.add(&d.stack.Languages, "Go")
+
+	for _, line := range strings.Split(content, "\n") {
+		fields := strings.Fields(strings.TrimPrefix(strings.TrimSpace(line), "require "))
+		if len(fields) == 0 {
+			continue
+		}
+		for _, prefix := range sortedKeys(goStack) {
+			if fields[0] == prefix || strings.HasPrefix(fields[0], prefix+"/") {
+				d.match(goStack, prefix)
+			}
+		}
+	}
+}
+
+func (d *stackDetector) cargo() {
+	content, ok := d.read("Cargo.toml")
+	if !ok {
+		return
+	}
+	d.source("Cargo.toml")
+	d.add(&d.stack.Languages, "Rust")
+
+	inDeps := false
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "[") {
+			inDeps = strings.Contains(line, "dependencies")
+			continue
+		}
+		if inDeps {
+			if name, _, ok := strings.Cut(line, "="); ok {
+				d.match(rustStack, strings.TrimSpace(name))
+			}
+		}
+	}
+}
+
+func (d *stackDetector) docker() {
+	if content, ok := d.read("Dockerfile"); ok {
+		d.source("Dockerfile")
+		d.add(&d.stack.Deployment, "Docker")
+		for _, line := range strings.Split(content, "\n") {
+			if m := fromRe.FindStringSubmatch(line); m != nil {
+				d.add(&d.stack.Deployment, "Docker base image "+m[1])
+			}
+		}
+	}
+
+	for _, file := range []string{"docker-compose.yml", "compose.yaml"} {
+		content, ok := d.read(file)
+		if !ok {
+			continue
+		}
+		for _, line := range strings.Split(content, "\n") {
+			if m := imageRe.FindStringSubmatch(line); m != nil {
+				name, _, _ := strings.Cut(filepath.Base(m[1]), ":")
+				d.add(&d.stack.Databases, imageDatabases[name])
+			}
+		}
+	}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,29 @@
+package health
+
+import (
+	"context"
+	"net/http"
+
+	"skene/internal/constants"
+	"skene/internal/services/httpclient"
+)
+
+// Probe reports whether url answers an HTTP HEAD request. Any response,
+// including 401 or 404, counts as reachable; only transport errors fail.
+// insecure skips TLS certificate checks, for the generic provider only.
+func Probe(ctx context.Context, url string, insecure bool) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, url, nil)
+	if err != nil {
+		return err
+	}
+	client := httpclient.New(constants.HTTPTimeout)
+	if insecure {
+		client = httpclient.NewInsecure(constants.HTTPTimeout)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
@@ -0,0 +1,132 @@
+// Package history records completed analyses in ~/.config/skene/history.json
+// so past results can be found again across projects.
+package history
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"skene/internal/constants"
+)
+
+// Entry is one completed analysis
+type Entry struct {
+	ProjectDir string    `json:"project_dir"`
+	OutputDir  string    `json:"output_dir"`
+	Provider   string    `json:"provider"`
+	Model      string    `json:"model"`
+	Timestamp  time.Time `json:"timestamp"`
+
+	// OutputFiles are the output_files renames in effect for the run, so
+	// its files are found after the setting changes
+	OutputFiles map[string]string `json:"output_files,omitempty"`
+}
+
+type historyFile struct {
+	Entries []Entry `json:"entries"`
+}
+
+// Path returns ~/.config/skene/history.json, or "" without a home directory
+func Path() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, constants.UserConfigDir, constants.HistoryFile)
+}
+
+// Load returns past analyses, newest first. Entries whose output directory
+// no longer exists are dropped, and the file is rewritten without them.
+func Load() []Entry {
+	path := Path()
+	entries := read(path)
+
+	var kept []Entry
+	for _, e := range entries {
+		if info, err := os.Stat(e.OutputDir); err == nil && info.IsDir() {
+			kept = append(kept, e)
+		}
+	}
+	if len(kept) != len(entries) {
+		write(path, kept)
+	}
+
+	sort.SliceStable(kept, func(i, j int) bool { return kept[i].Timestamp.After(kept[j].Timestamp) })
+	return kept
+}
+
+// Record adds a completed analysis. A rerun of the same project and output
+// directory replaces its earlier entry, and only the newest
+// constants.MaxHistoryEntries are kept.
+func Record(entry Entry) error {
+	path := Path()
+	if path == "" {
+		return nil
+	}
+	if entry.Timestamp.IsZero() {
+		entry.Timestamp = time.Now()
+	}
+
+	entries := []Entry{entry}
+	for _, e := range read(path) {
+		if e.ProjectDir != entry.ProjectDir || e.OutputDir != entry.OutputDir {
+			entries = append(entries, e)
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].Timestamp.After(entries[j].Timestamp) })
+	if len(entries) > constants.MaxHistoryEntries {
+		entries = entries[:constants.MaxHistoryEntries]
+	}
+	return write(path, entries)
+}
+
+func read(path string) []Entry {
+	if path == "" {
+		return nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	var file historyFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil
+	}
+	return file.Entries
+}
+
+func write(path string, entries []Entry) error {
+	if path == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(historyFile{Entries: entries}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	// Written to a temporary file and renamed, so a torn write never
+	// leaves a file that reads as empty and wipes the history
+	tmp, err := os.CreateTemp(filepath.Dir(path), constants.HistoryFile+".*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), 0644); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,192 @@
+// Package modelcatalog fetches the models a provider currently serves, so
+// the model list can be refreshed without a new release.
+package modelcatalog
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"skene/internal/constants"
+	"skene/internal/services/config"
+	"skene/internal/services/httpclient"
+)
+
+// ErrUnsupported is returned for providers without a model list endpoint
+var ErrUnsupported = errors.New("this provider does not list its models")
+
+// Fetch returns the chat models the provider serves for apiKey, newest
+// first where the API says. baseURL and insecure, which skips TLS
+// certificate checks, are only used by the generic provider. Models that
+// are also in the built-in list keep its description.
+func Fetch(ctx context.Context, providerID, apiKey, baseURL string, insecure bool) ([]config.Model, error) {
+	var models []config.Model
+	var err error
+	switch providerID {
+	case "openai":
+		models, err = fetchOpenAI(ctx, httpclient.New(constants.HTTPTimeout), constants.OpenAIModelsURL, apiKey, isOpenAIChatModel)
+	case "generic":
+		if baseURL == "" {
+			return nil, fmt.Errorf("no base URL set")
+		}
+		client := httpclient.New(constants.HTTPTimeout)
+		if insecure {
+			client = httpclient.NewInsecure(constants.HTTPTimeout)
+		}
+		models, err = fetchOpenAI(ctx, client, strings.TrimRight(baseURL, "/")+"/models", apiKey, nil)
+	case "anthropic":
+		models, err = fetchAnthropic(ctx, apiKey)
+	case "gemini":
+		models, err = fetchGemini(ctx, apiKey)
+	default:
+		return nil, ErrUnsupported
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(models) == 0 {
+		return nil, fmt.Errorf("the provider returned no usable models")
+	}
+
+	for i, m := range models {
+		if known := config.GetModelByID(providerID, m.ID); known != nil {
+			if models[i].Description == "" {
+				models[i].Description = known.Description
+			}
+		}
+	}
+	return models, nil
+}
+
+// get sends an authenticated GET and decodes the JSON response into out
+func get(ctx context.Context, client *http.Client, url string, headers map[string]string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return fmt.Errorf("the API key was rejected (HTTP %d)", resp.StatusCode)
+	case resp.StatusCode != http.StatusOK:
+		return fmt.Errorf("HTTP %d from %s", resp.StatusCode, req.URL.Host)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to parse model list: %w", err)
+	}
+	return nil
+}
+
+// openAIExcluded marks OpenAI models that are not chat models
+var openAIExcluded = []string{"audio", "realtime", "transcribe", "tts", "image", "search", "embedding", "instruct", "moderation"}
+
+func isOpenAIChatModel(id string) bool {
+	if !strings.HasPrefix(id, "gpt-") && !strings.HasPrefix(id, "chatgpt-") &&
+		!strings.HasPrefix(id, "o1") && !strings.HasPrefix(id, "o3") && !strings.HasPrefix(id, "o4") {
+		return false
+	}
+	for _, word := range openAIExcluded {
+		if strings.Contains(id, word) {
+			return false
+		}
+	}
+	return true
+}
+
+// fetchOpenAI reads an OpenAI-compatible /models list. keep filters model
+// IDs; nil keeps every model.
+func fetchOpenAI(ctx context.Context, client *http.Client, url, apiKey string, keep func(string) bool) ([]config.Model, error) {
+	var body struct {
+		Data []struct {
+			ID      string `json:"id"`
+			Created int64  `json:"created"`
+		} `json:"data"`
+	}
+	headers := map[string]string{}
+	if apiKey != "" {
+		headers["Authorization"] = "Bearer " + apiKey
+	}
+	if err := get(ctx, client, url, headers, &body); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(body.Data, func(i, j int) bool { return body.Data[i].Created > body.Data[j].Created })
+	var models []config.Model
+	for _, m := range body.Data {
+		if m.ID != "" && (keep == nil || keep(m.ID)) {
+			models = append(models, config.Model{ID: m.ID, Name: m.ID})
+		}
+	}
+	return models, nil
+}
+
+func fetchAnthropic(ctx context.Context, apiKey string) ([]config.Model, error) {
+	var body struct {
+		Data []struct {
+			ID          string `json:"id"`
+			DisplayName string `json:"display_name"`
+		} `json:"data"`
+	}
+	headers := map[string]string{
+		"x-api-key":         apiKey,
+		"anthropic-version": constants.AnthropicAPIVersion,
+	}
+	if err := get(ctx, httpclient.New(constants.HTTPTimeout), constants.AnthropicModelsURL, headers, &body); err != nil {
+		return nil, err
+	}
+
+	// Listed newest first
+	var models []config.Model
+	for _, m := range body.Data {
+		if m.ID != "" {
+			models = append(models, config.Model{ID: m.ID, Name: m.ID, Description: m.DisplayName})
+		}
+	}
+	return models, nil
+}
+
+func fetchGemini(ctx context.Context, apiKey string) ([]config.Model, error) {
+	var body struct {
+		Models []struct {
+			Name        string   `json:"name"`
+			DisplayName string   `json:"displayName"`
+			Methods     []string `json:"supportedGenerationMethods"`
+		} `json:"models"`
+	}
+	headers := map[string]string{"x-goog-api-key": apiKey}
+	if err := get(ctx, httpclient.New(constants.HTTPTimeout), constants.GeminiModelsURL, headers, &body); err != nil {
+		return nil, err
+	}
+
+	var models []config.Model
+	for _, m := range body.Models {
+		id := strings.TrimPrefix(m.Name, "models/")
+		if !strings.HasPrefix(id, "gemini") || !supports(m.Methods, "generateContent") {
+			continue
+		}
+		models = append(models, config.Model{ID: id, Name: id, Description: m.DisplayName})
+	}
+	return models, nil
+}
+
+func supports(methods []string, method string) bool {
+	for _, m := range methods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
@@ -1,9 +1,14 @@
 package syscheck
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"os/exec"
 	"strings"
 
+	"skene/internal/constants"
+	"skene/internal/services/config"
 	"skene/internal/services/uvresolver"
 )
 
@@ -59,12 +64,25 @@ func (c *Checker) GetResults() *SystemCheckResult {
 }
 
 // RunAllChecks verifies that a uvx binary is available (system PATH or auto-provisioned).
+// Results are reset first, so calling it again after the user fixed a
+// prerequisite reports the new state rather than the old failure.
 func (c *Checker) RunAllChecks() *SystemCheckResult {
-	c.checkUVX()
+	return c.RunAllChecksContext(context.Background())
+}
+
+// RunAllChecksContext is RunAllChecks bounded by ctx. Cancelling ctx aborts
+// any command still running; each command is also limited to
+// constants.SysCheckCommandTimeout and reported as failed when it takes longer.
+func (c *Checker) RunAllChecksContext(ctx context.Context) *SystemCheckResult {
+	c.results = &SystemCheckResult{
+		AllPassed:  true,
+		CanProceed: true,
+	}
+	c.checkUVX(ctx)
 	return c.results
 }
 
-func (c *Checker) checkUVX() {
+func (c *Checker) checkUVX(ctx context.Context) {
 	c.results.UV = CheckResult{
 		Name:     "uvx runtime",
 		Required: true,
@@ -80,7 +98,17 @@ func (c *Checker) checkUVX() {
 		return
 	}
 
-	out, err := exec.Command(uvxPath, "--version").Output()
+	cmdCtx, cancel := context.WithTimeout(ctx, constants.SysCheckCommandTimeout)
+	defer cancel()
+	out, err := exec.CommandContext(cmdCtx, uvxPath, "--version").Output()
+	if errors.Is(cmdCtx.Err(), context.DeadlineExceeded) {
+		c.results.UV.Status = StatusFailed
+		c.results.UV.Message = fmt.Sprintf("uvx --version timed out after %s", constants.SysCheckCommandTimeout)
+		c.results.UV.Detail = "The uvx binary did not respond. A broken shim earlier on PATH can cause this."
+		c.results.AllPassed = false
+		c.results.CanProceed = false
+		return
+	}
 	if err != nil {
 		c.results.UV.Status = StatusWarning
 		c.results.UV.Message = "uvx found but version check failed"
@@ -93,6 +121,99 @@ func (c *Checker) checkUVX() {
 	c.results.UV.Version = version
 }
 
+// CheckGit verifies that git is on PATH. It is only needed to clone a
+// repository given by URL, so it is not part of RunAllChecks.
+func CheckGit(ctx context.Context) CheckResult {
+	result := CheckResult{
+		Name:     "git",
+		Required: true,
+		FixURL:   "https://git-scm.com/downloads",
+	}
+
+	gitPath, err := exec.LookPath("git")
+	if err != nil {
+		result.Status = StatusFailed
+		result.Message = "git is not installed"
+		return result
+	}
+
+	cmdCtx, cancel := context.WithTimeout(ctx, constants.SysCheckCommandTimeout)
+	defer cancel()
+	out, err := exec.CommandContext(cmdCtx, gitPath, "--version").Output()
+	if errors.Is(cmdCtx.Err(), context.DeadlineExceeded) {
+		result.Status = StatusFailed
+		result.Message = fmt.Sprintf("git --version timed out after %s", constants.SysCheckCommandTimeout)
+		return result
+	}
+	if err != nil {
+		result.Status = StatusWarning
+		result.Message = "git found but version check failed"
+		return result
+	}
+
+	result.Status = StatusPassed
+	result.Message = "git ready"
+	result.Version = strings.TrimSpace(string(out))
+	return result
+}
+
+// CheckPython looks for a Python interpreter on PATH that is at least min,
+// given as "MAJOR.MINOR". uv normally downloads a suitable Python itself, so
+// this only matters when it cannot, as in offline mode.
+func CheckPython(ctx context.Context, min string) CheckResult {
+	result := CheckResult{
+		Name:     "Python",
+		Required: true,
+		FixURL:   "https://www.python.org/downloads/",
+	}
+
+	required, err := config.ParsePythonVersion(min)
+	if err != nil {
+		result.Status = StatusFailed
+		result.Message = "invalid min_python: " + err.Error()
+		return result
+	}
+	result.FixCommand = "uv python install " + required.String()
+	result.Detail = fmt.Sprintf("Install Python %s+ from python.org or your package manager, or run '%s' while online.", required, result.FixCommand)
+
+	var found []string
+	for _, name := range []string{"python3", "python"} {
+		path, err := exec.LookPath(name)
+		if err != nil {
+			continue
+		}
+
+		cmdCtx, cancel := context.WithTimeout(ctx, constants.SysCheckCommandTimeout)
+		out, err := exec.CommandContext(cmdCtx, path, "--version").CombinedOutput()
+		cancel()
+		if err != nil {
+			continue
+		}
+		version, err := config.ParsePythonVersion(string(out))
+		if err != nil {
+			continue
+		}
+		if version.AtLeast(required) {
+			result.Status = StatusPassed
+			result.Message = "Python ready"
+			result.Version = strings.TrimSpace(string(out))
+			return result
+		}
+		// python and python3 are often the same interpreter
+		if len(found) == 0 || found[len(found)-1] != version.String() {
+			found = append(found, version.String())
+		}
+	}
+
+	result.Status = StatusFailed
+	if len(found) == 0 {
+		result.Message = fmt.Sprintf("Python %s+ is required but was not found in your PATH", required)
+	} else {
+		result.Message = fmt.Sprintf("Python %s+ is required but only %s was found", required, strings.Join(found, ", "))
+	}
+	return result
+}
+
 // GetAlternativeInstallCommands returns alternative install methods
 func (c *Checker) GetAlternativeInstallCommands() []string {
 	return []string{
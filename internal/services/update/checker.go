@@ -0,0 +1,125 @@
+package update
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"skene/internal/constants"
+	"skene/internal/services/httpclient"
+)
+
+// Release holds the fields we care about from the releases endpoint
+type Release struct {
+	TagName string `json:"tag_name"`
+	HTMLURL string `json:"html_url"`
+}
+
+// Checker queries a release endpoint for the latest published version
+type Checker struct {
+	url     string
+	current string
+	client  *http.Client
+}
+
+// NewChecker creates a checker for the given endpoint. An empty url falls
+// back to the GitHub releases API for this repository.
+func NewChecker(url, current string) *Checker {
+	if url == "" {
+		url = constants.UpdateCheckURL
+	}
+	return &Checker{
+		url:     url,
+		current: current,
+		client:  httpclient.New(constants.HTTPTimeout),
+	}
+}
+
+// Latest returns the newest release if it is ahead of the current version,
+// or nil when the binary is already up to date.
+func (c *Checker) Latest(ctx context.Context) (*Release, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build update request: %w", err)
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("update check failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("update check failed: HTTP %d", resp.StatusCode)
+	}
+
+	var release Release
+	if err := json.NewDecoder(resp.Body).Decode(&release); err != nil {
+		return nil, fmt.Errorf("failed to decode release: %w", err)
+	}
+
+	if release.TagName == "" || !IsNewer(release.TagName, c.current) {
+		return nil, nil
+	}
+	return &release, nil
+}
+
+// IsNewer reports whether version latest is strictly ahead of current.
+// Unparseable versions are never considered newer.
+func IsNewer(latest, current string) bool {
+	l, ok := parseVersion(latest)
+	if !ok {
+		return false
+	}
+	c, ok := parseVersion(current)
+	if !ok {
+		return false
+	}
+
+	for i := 0; i < len(l) || i < len(c); i++ {
+		var lv, cv int
+		if i < len(l) {
+			lv = l[i]
+		}
+		if i < len(c) {
+			cv = c[i]
+		}
+		if lv != cv {
+			return lv > cv
+		}
+	}
+	return false
+}
+
+// parseVersion splits "v1.2.3" into its numeric parts. Release tags are
+// also cut without dots (e.g. "v030"), which are read digit by digit.
+func parseVersion(v string) ([]int, bool) {
+	v = strings.TrimPrefix(strings.TrimSpace(v), "v")
+	if i := strings.IndexAny(v, "-+"); i >= 0 {
+		v = v[:i]
+	}
+	if v == "" {
+		return nil, false
+	}
+
+	var parts []string
+	if strings.Contains(v, ".") {
+		parts = strings.Split(v, ".")
+	} else {
+		parts = strings.Split(v, "")
+	}
+
+	nums := make([]int, 0, len(parts))
+	for _, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil {
+			return nil, false
+		}
+		nums = append(nums, n)
+	}
+	return nums, true
+}
@@ -4,6 +4,7 @@ import (
 	"archive/tar"
 	"archive/zip"
 	"compress/gzip"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -14,11 +15,43 @@ import (
 	"strings"
 
 	"skene/internal/constants"
+	"skene/internal/services/httpclient"
 )
 
+// ErrNotInstalled is returned by ResolveLocal when no uvx binary is present
+var ErrNotInstalled = errors.New("uvx not found on PATH or in the local cache")
+
+// ProgressFunc receives download progress while uv is being provisioned.
+// total is -1 when the server does not report a content length.
+type ProgressFunc func(done, total int64)
+
 // Resolve returns the absolute path to a working uvx binary.
 // It checks: (1) system PATH, (2) ~/.skene/bin/ cache, (3) auto-downloads.
 func Resolve() (string, error) {
+	return ResolveWithProgress(nil)
+}
+
+// ResolveWithProgress is like Resolve but reports download progress when
+// uv has to be fetched. progress may be nil.
+func ResolveWithProgress(progress ProgressFunc) (string, error) {
+	if path, err := ResolveLocal(); err != ErrNotInstalled {
+		return path, err
+	}
+
+	cacheDir, err := cacheDirectory()
+	if err != nil {
+		return "", fmt.Errorf("cannot determine cache directory: %w", err)
+	}
+
+	if err := ensureCached(cacheDir, progress); err != nil {
+		return "", err
+	}
+
+	return filepath.Join(cacheDir, uvxBinaryName()), nil
+}
+
+// ResolveLocal is like Resolve but never downloads; it is used in offline mode.
+func ResolveLocal() (string, error) {
 	if path, err := exec.LookPath("uvx"); err == nil {
 		return path, nil
 	}
@@ -33,11 +66,15 @@ func Resolve() (string, error) {
 		return cachedPath, nil
 	}
 
-	if err := ensureCached(cacheDir); err != nil {
-		return "", err
-	}
+	return "", ErrNotInstalled
+}
 
-	return cachedPath, nil
+// InstallCommand returns the official uv install one-liner for this platform
+func InstallCommand() string {
+	if runtime.GOOS == "windows" {
+		return constants.UVInstallCommandWindows
+	}
+	return constants.UVInstallCommandUnix
 }
 
 func cacheDirectory() (string, error) {
@@ -62,14 +99,14 @@ func uvBinaryName() string {
 	return "uv"
 }
 
-func ensureCached(cacheDir string) error {
+func ensureCached(cacheDir string, progress ProgressFunc) error {
 	if err := os.MkdirAll(cacheDir, 0755); err != nil {
 		return fmt.Errorf("failed to create cache directory %s: %w", cacheDir, err)
 	}
-	return downloadUV(cacheDir)
+	return downloadUV(cacheDir, progress)
 }
 
-func downloadUV(cacheDir string) error {
+func downloadUV(cacheDir string, progress ProgressFunc) error {
 	archive, err := platformArchive()
 	if err != nil {
 		return err
@@ -77,7 +114,7 @@ func downloadUV(cacheDir string) error {
 
 	url := constants.UVDownloadBaseURL + "/" + archive
 
-	resp, err := http.Get(url)
+	resp, err := httpclient.New(constants.UVDownloadTimeout).Get(url)
 	if err != nil {
 		return fmt.Errorf("failed to download uv from %s: %w", url, err)
 	}
@@ -87,10 +124,33 @@ func downloadUV(cacheDir string) error {
 		return fmt.Errorf("failed to download uv: HTTP %d from %s", resp.StatusCode, url)
 	}
 
+	var body io.Reader = resp.Body
+	if progress != nil {
+		progress(0, resp.ContentLength)
+		body = &progressReader{r: resp.Body, total: resp.ContentLength, report: progress}
+	}
+
 	if strings.HasSuffix(archive, ".zip") {
-		return extractZip(resp.Body, cacheDir)
+		return extractZip(body, cacheDir)
+	}
+	return extractTarGz(body, cacheDir)
+}
+
+// progressReader reports bytes read so far on every Read
+type progressReader struct {
+	r      io.Reader
+	done   int64
+	total  int64
+	report ProgressFunc
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	if n > 0 {
+		p.done += int64(n)
+		p.report(p.done, p.total)
 	}
-	return extractTarGz(resp.Body, cacheDir)
+	return n, err
 }
 
 func platformArchive() (string, error) {
@@ -4,17 +4,31 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"runtime"
+	"runtime/debug"
+	"strings"
+	"sync"
 	"time"
 
 	"skene/internal/constants"
 	"skene/internal/game"
+	"skene/internal/keymap"
 	"skene/internal/services/auth"
 	"skene/internal/services/config"
+	"skene/internal/services/gitclone"
 	"skene/internal/services/growth"
+	"skene/internal/services/health"
+	"skene/internal/services/history"
+	"skene/internal/services/httpclient"
+	"skene/internal/services/modelcatalog"
+	"skene/internal/services/syscheck"
+	"skene/internal/services/update"
+	"skene/internal/services/uvresolver"
 	"skene/internal/tui/components"
 	"skene/internal/tui/styles"
 	"skene/internal/tui/views"
 
+	"github.com/atotto/clipboard"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
@@ -35,7 +49,6 @@ const (
 	StateModelSelect                    // Model selection for chosen provider
 	StateAuth                           // Skene magic link authentication
 	StateAPIKey                         // Manual API key entry
-	StateLocalModel                     // Local model detection (Ollama/LM Studio)
 	StateProjectDir                     // Project directory selection
 	StateAnalysisConfig                 // Analysis configuration
 	StateAnalyzing                      // Analysis progress
@@ -43,8 +56,45 @@ const (
 	StateNextSteps                      // Next steps after analysis
 	StateError                          // Error display
 	StateGame                           // Mini game during wait
+	StateCloning                        // Cloning a repository given by URL
+	StateHistory                        // Past analyses
 )
 
+// String returns the screen's name for messages
+func (s AppState) String() string {
+	switch s {
+	case StateWelcome:
+		return constants.StepNameWelcome
+	case StateProviderSelect:
+		return constants.StepNameAIProvider
+	case StateModelSelect:
+		return constants.StepNameSelectModel
+	case StateAuth:
+		return constants.StepNameAuthentication
+	case StateAPIKey:
+		return constants.StepNameAPIKey
+	case StateProjectDir:
+		return constants.StepNameProjectDir
+	case StateAnalysisConfig:
+		return constants.StepNameAnalysisConfig
+	case StateAnalyzing:
+		return constants.StepNameAnalysingStepper
+	case StateResults:
+		return constants.StepNameResults
+	case StateNextSteps:
+		return constants.StepNameNextSteps
+	case StateError:
+		return constants.StepNameError
+	case StateGame:
+		return constants.StepNameGame
+	case StateCloning:
+		return constants.StepNameCloning
+	case StateHistory:
+		return constants.StepNameHistory
+	}
+	return fmt.Sprintf("state %d", int(s))
+}
+
 // ═══════════════════════════════════════════════════════════════════
 // MESSAGES
 // ═══════════════════════════════════════════════════════════════════
@@ -71,9 +121,12 @@ type NextStepOutputMsg struct {
 	Line string
 }
 
-// NextStepDoneMsg is sent when a next-step command finishes
+// NextStepDoneMsg is sent when a next-step command finishes. Section and
+// Content are set when a single results tab was regenerated.
 type NextStepDoneMsg struct {
-	Error error
+	Error   error
+	Section growth.Section
+	Content string
 }
 
 // PromptMsg is sent when uvx asks an interactive question
@@ -83,10 +136,14 @@ type PromptMsg struct {
 	Response chan string
 }
 
-// LocalModelDetectMsg is sent with local model detection results
-type LocalModelDetectMsg struct {
-	Models []string
-	Error  error
+// AuthBrowserMsg reports whether the auth page could be opened
+type AuthBrowserMsg struct {
+	Error error
+}
+
+// DashboardBrowserMsg reports whether the Skene dashboard could be opened
+type DashboardBrowserMsg struct {
+	Error error
 }
 
 // AuthCallbackMsg is sent when the API key is received from the external auth website
@@ -96,8 +153,67 @@ type AuthCallbackMsg struct {
 	Error  error
 }
 
-// authVerifiedMsg triggers the transition from verifying to success state
-type authVerifiedMsg struct{}
+// UpdateCheckMsg is sent when the background update check finds a newer release
+type UpdateCheckMsg struct {
+	Latest string
+}
+
+// ProviderHealthMsg reports whether a provider's API endpoint is reachable
+type ProviderHealthMsg struct {
+	ProviderID string
+	Reachable  bool
+}
+
+// RepoSizeMsg carries the file count of the pre-scan for a project
+type RepoSizeMsg struct {
+	ProjectDir string
+	Size       growth.RepoSize
+}
+
+// CloneProgressMsg reports git clone progress to the view that started it
+type CloneProgressMsg struct {
+	View     *views.CloningView
+	Progress gitclone.Progress
+}
+
+// CloneDoneMsg is sent when a clone finishes. GitMissing is set when git
+// is not installed, so nothing was attempted.
+type CloneDoneMsg struct {
+	View       *views.CloningView
+	Dir        string
+	Err        error
+	GitMissing bool
+}
+
+// UVInstallProgressMsg reports uv download progress to the error view that
+// started it. Total is -1 when the size is unknown.
+type UVInstallProgressMsg struct {
+	View        *views.ErrorView
+	Done, Total int64
+}
+
+// UVInstallDoneMsg is sent when a uv download started from the error view
+// finishes and uvx has been checked
+type UVInstallDoneMsg struct {
+	View *views.ErrorView
+	Err  error
+}
+
+// ModelsFetchedMsg carries the live model list fetched for a provider
+type ModelsFetchedMsg struct {
+	ProviderID string
+	Models     []config.Model
+	Err        error
+}
+
+// authKeyCheckedMsg reports whether the key from the sign-in page was
+// accepted. ID matches App.authCheckID for the check still pending.
+type authKeyCheckedMsg struct {
+	ID     int
+	APIKey string
+	Model  string
+	Err    error
+}
 
 // authSuccessTransitionMsg triggers the transition after showing auth success
 type authSuccessTransitionMsg struct{}
@@ -109,11 +225,11 @@ type authSuccessTransitionMsg struct{}
 // App is the main Bubble Tea application model implementing the wizard
 type App struct {
 	// Core state
-	state     AppState
-	prevState AppState
-	width     int
-	height    int
-	time      float64
+	state    AppState
+	navStack []AppState // screens to return to on esc, most recent last
+	width    int
+	height   int
+	time     float64
 
 	// Services
 	configMgr *config.Manager
@@ -128,13 +244,17 @@ type App struct {
 	modelView          *views.ModelView
 	authView           *views.AuthView
 	apiKeyView         *views.APIKeyView
-	localModelView     *views.LocalModelView
 	projectDirView     *views.ProjectDirView
 	analysisConfigView *views.AnalysisConfigView
 	analyzingView      *views.AnalyzingView
 	resultsView        *views.ResultsView
 	nextStepsView      *views.NextStepsView
 	errorView          *views.ErrorView
+	cloningView        *views.CloningView
+	historyView        *views.HistoryView
+
+	// Key bindings from the key_bindings preset and key_map overrides
+	keys *keymap.KeyMap
 
 	// Help overlay
 	helpOverlay *components.HelpOverlay
@@ -147,8 +267,10 @@ type App struct {
 	analysisStartTime time.Time
 
 	// Cancellation for running processes
-	cancelFunc      context.CancelFunc
-	analyzingOrigin AppState // state to return to when cancelling/failing
+	cancelFunc context.CancelFunc
+	// Engine commands still running, waited on at exit so a cancelled
+	// analysis can remove its temporary files
+	background sync.WaitGroup
 
 	// Auth state
 	authCountdown  int
@@ -157,11 +279,76 @@ type App struct {
 	// Error state
 	currentError *views.ErrorInfo
 
+	// Consecutive identical analysis failures for the same configuration
+	failureKey   string
+	failureCount int
+
+	// reauth is set while signing in to Skene again after the saved key was
+	// rejected; success reruns the analysis instead of continuing the wizard
+	reauth bool
+
+	// Incremented for each check of a signed-in key, so a superseded
+	// result is ignored
+	authCheckID int
+
 	// Interactive prompt state
 	pendingPromptResponse chan string
 
+	// slowPrompt is set while the analyzing screen asks whether to keep
+	// waiting for a silent provider; slowSnoozed is when the user last
+	// chose to keep waiting. keepWaiting restarts the running engine's idle
+	// watchdog.
+	slowPrompt  bool
+	slowSnoozed time.Time
+	keepWaiting func()
+
+	// Update check
+	noUpdateCheck bool
+
+	// Set by --no-animation; overrides the animations_enabled config
+	noAnimation bool
+
+	// Set by --skip-setup; see also the skip_setup config
+	skipSetup bool
+
+	// Set by --yes; see also the skip_analysis_config config
+	autoAccept bool
+
+	// True while a global TickMsg is scheduled. Screens without spinners or
+	// animation let it lapse; Update restarts it when one is entered.
+	tickRunning bool
+
+	// Set once magic-link auth returned a key, so model selection skips
+	// the API key step
+	authenticated bool
+
+	// Set while waiting for the user to confirm leaving a running analysis
+	confirmHome bool
+	// Set while waiting for the user to confirm regenerating the plan
+	confirmRegenerate bool
+
+	// Provider reachability is probed once per session
+	providerHealthStarted bool
+
+	// Project directory given via --project / SKENE_PROJECT
+	presetProjectDir string
+
+	// Provider the configured API key belongs to, used to fetch live
+	// model lists before a key is entered again
+	keyProvider string
+
+	// Repository URL given via --project, cloned before project selection
+	remote *remoteRepo
+
 	// Program reference for sending messages from background tasks
 	program *tea.Program
+
+	// Set once a panic was recovered; crashPath is where the report was
+	// saved, or crashErr why it could not be
+	crashed     bool
+	crashReport []byte
+	crashPath   string
+	crashErr    error
 }
 
 // ═══════════════════════════════════════════════════════════════════
@@ -173,11 +360,6 @@ func NewApp() *App {
 	configMgr := config.NewManager(".")
 	configMgr.LoadConfig()
 
-	// Set default values if not present
-	if configMgr.Config.OutputDir == "" {
-		configMgr.Config.OutputDir = "./skene-context"
-	}
-
 	app := &App{
 		state:        StateWelcome,
 		configMgr:    configMgr,
@@ -185,20 +367,193 @@ func NewApp() *App {
 		providerView: views.NewProviderView(),
 		helpOverlay:  components.NewHelpOverlay(),
 	}
+	app.applyLoadedConfig()
 
 	return app
 }
 
+// applyLoadedConfig sets up the proxy, spinner, key bindings and welcome
+// screen from a freshly loaded config
+func (a *App) applyLoadedConfig() {
+	cfg := a.configMgr.Config
+	httpclient.SetProxy(cfg.Proxy)
+	components.SetDefaultSpinnerStyle(cfg.SpinnerStyle)
+
+	// Set default values if not present
+	if cfg.OutputDir == "" {
+		cfg.OutputDir = "./skene-context"
+	}
+
+	a.refreshSavedConfig()
+	a.keyProvider = ""
+	if cfg.APIKey != "" {
+		a.keyProvider = cfg.Provider
+	}
+
+	km, err := keymap.New(cfg.KeyBindings, cfg.KeyMap)
+	if err != nil {
+		km = keymap.Default()
+	}
+	a.keys = km
+}
+
 // SetProgram sets the tea.Program reference for sending messages from background tasks
 func (a *App) SetProgram(p *tea.Program) {
 	a.program = p
 }
 
+// DisableUpdateCheck turns off the background release check (--no-update-check)
+func (a *App) DisableUpdateCheck() {
+	a.noUpdateCheck = true
+}
+
+// SetCredentials overrides the configured provider, model and API key
+// (--provider, --model, --api-key). Empty values keep the config.
+func (a *App) SetCredentials(provider, model, apiKey string) {
+	if provider != "" {
+		a.configMgr.SetProvider(provider)
+	}
+	if model != "" {
+		a.configMgr.SetModel(model)
+	}
+	if apiKey != "" {
+		a.configMgr.SetAPIKey(apiKey)
+		a.keyProvider = a.configMgr.Config.Provider
+	}
+	a.refreshSavedConfig()
+}
+
+// EnableSkipSetup starts on project selection when the configured
+// provider, model and key are valid (--skip-setup)
+func (a *App) EnableSkipSetup() {
+	a.skipSetup = true
+}
+
+// EnableAutoAccept starts the analysis with the saved settings as soon as
+// a project is chosen (--yes)
+func (a *App) EnableAutoAccept() {
+	a.autoAccept = true
+}
+
+// canSkipSetup reports whether the wizard's provider, model and key
+// screens can be skipped. Anything missing or invalid falls back to the
+// normal flow.
+func (a *App) canSkipSetup() bool {
+	if !a.skipSetup && !a.configMgr.Config.SkipSetup {
+		return false
+	}
+	if !a.configMgr.HasValidConfig() || len(a.configMgr.Validate()) > 0 {
+		return false
+	}
+	return a.restoreConfiguredSelection()
+}
+
+// DisableAnimation shows a static welcome screen (--no-animation)
+func (a *App) DisableAnimation() {
+	a.noAnimation = true
+}
+
+// animationsEnabled reports whether the welcome animation should play
+func (a *App) animationsEnabled() bool {
+	return !a.noAnimation && a.configMgr.Config.AnimationsEnabled
+}
+
+// needsTick reports whether the current screen redraws off the global tick
+func (a *App) needsTick() bool {
+	switch a.state {
+	case StateWelcome:
+		return a.animationsEnabled()
+	case StateAnalyzing, StateAuth, StateAPIKey, StateGame, StateCloning:
+		return true
+	}
+	return false
+}
+
+// SetOffline enables offline mode (--offline): no update check, no uv
+// download and no network install suggestions
+func (a *App) SetOffline() {
+	a.configMgr.SetOffline()
+}
+
+// SetProjectDir pre-selects the project directory (--project). The
+// directory screen is skipped and, when provider, model and key are already
+// configured, the welcome screen jumps straight to the analysis config.
+// The project's own config replaces the one found from the working
+// directory, so call this before the other flag overrides.
+func (a *App) SetProjectDir(dir string) {
+	a.presetProjectDir = dir
+	if a.configMgr.UseProject(dir) {
+		a.applyLoadedConfig()
+	}
+}
+
+// useProject makes dir the current project and loads its project config.
+// The provider, model and key picked in the wizard are kept over whatever
+// that config says.
+func (a *App) useProject(dir string) {
+	prev := *a.configMgr.Config
+	if !a.configMgr.UseProject(dir) {
+		return
+	}
+	cfg := a.configMgr.Config
+	if prev.Provider != "" {
+		cfg.Provider = prev.Provider
+		cfg.Model = prev.Model
+		cfg.APIKey = prev.APIKey
+		cfg.BaseURL = prev.BaseURL
+		cfg.GenericBaseURL = prev.GenericBaseURL
+		cfg.InsecureSkipVerify = prev.InsecureSkipVerify
+	}
+	keyProvider := a.keyProvider
+	a.applyLoadedConfig()
+	if prev.Provider != "" {
+		a.keyProvider = keyProvider
+	}
+}
+
+// remoteRepo is a --project URL and, once cloned, its temporary directory
+type remoteRepo struct {
+	url  string
+	dir  string
+	keep bool // set by --keep-clone
+}
+
+// SetRemoteProject analyzes a git repository by URL (--project). It is
+// shallow-cloned into a temporary directory in place of the directory
+// screen; RemoveClone deletes it on exit unless keep is set.
+func (a *App) SetRemoteProject(url string, keep bool) {
+	a.remote = &remoteRepo{url: url, keep: keep}
+}
+
+// RemoveClone deletes the clone made for a --project URL. When the clone
+// is kept instead, its path is returned so it can be shown.
+func (a *App) RemoveClone() string {
+	if a.remote == nil || a.remote.dir == "" {
+		return ""
+	}
+	if a.remote.keep {
+		return a.remote.dir
+	}
+	gitclone.Remove(a.remote.dir)
+	a.remote.dir = ""
+	return ""
+}
+
 // Init initializes the application
 func (a *App) Init() tea.Cmd {
 	var cmds []tea.Cmd
-	cmds = append(cmds, tick())
+	if a.canSkipSetup() {
+		cmds = append(cmds, a.transitionToProjectDir())
+	}
+	a.welcomeView.SetStatic(!a.animationsEnabled())
+	if a.needsTick() {
+		a.tickRunning = true
+		cmds = append(cmds, tick())
+	}
 	cmds = append(cmds, textinput.Blink)
+	if cmd := a.checkForUpdates(); cmd != nil {
+		cmds = append(cmds, cmd)
+	}
 	// Initialize welcome animation
 	if a.welcomeView != nil {
 		animCmd := a.welcomeView.InitAnimation()
@@ -213,25 +568,63 @@ func (a *App) Init() tea.Cmd {
 // UPDATE
 // ═══════════════════════════════════════════════════════════════════
 
-// Update handles messages and updates state
-func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+// Update handles messages and updates state. A panic is recorded as a
+// crash report and quits, so the terminal is restored normally.
+func (a *App) Update(msg tea.Msg) (model tea.Model, cmd tea.Cmd) {
+	defer func() {
+		if r := recover(); r != nil {
+			a.RecordCrash(r, debug.Stack())
+			model, cmd = a, tea.Quit
+		}
+	}()
+	return a.update(msg)
+}
+
+func (a *App) update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmds []tea.Cmd
 
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		// Global: ctrl+c always quits
-		if msg.String() == "ctrl+c" {
-			return a, tea.Quit
+		action := a.keys.Action(msg.String(), a.isTextInputState())
+		if msg.String() == "ctrl+c" || action == keymap.Quit {
+			return a, a.quit()
+		}
+
+		// Confirmation for going home mid-analysis swallows the next key
+		if a.confirmHome {
+			a.confirmHome = false
+			if msg.String() == "y" || msg.String() == "ctrl+h" {
+				return a, a.goHome()
+			}
+			return a, nil
+		}
+		if a.confirmRegenerate {
+			a.confirmRegenerate = false
+			if msg.String() == "y" {
+				return a, a.regenerateSection()
+			}
+			return a, nil
+		}
+
+		// Global: ctrl+h returns to the welcome screen, except where the
+		// key would be typed into a text field
+		if msg.String() == "ctrl+h" && !a.isTextInputState() {
+			if a.isAnalysisRunning() {
+				a.confirmHome = true
+				return a, nil
+			}
+			return a, a.goHome()
 		}
 
 		// Help toggle
-		if msg.String() == "?" && a.state != StateAPIKey && a.state != StateProjectDir {
+		if action == keymap.Help {
 			a.showHelp = !a.showHelp
 			return a, nil
 		}
 
 		// Close help on any key
-		if a.showHelp && msg.String() != "?" {
+		if a.showHelp && action != keymap.Help {
 			a.showHelp = false
 			return a, nil
 		}
@@ -259,6 +652,7 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if a.state == StateAnalyzing && a.analyzingView != nil {
 			a.analyzingView.TickSpinner()
 			// Real analysis progress is updated via AnalysisPhaseMsg
+			a.checkSlowProvider()
 		}
 		if a.state == StateAuth && a.authView != nil {
 			a.authView.TickSpinner()
@@ -266,8 +660,8 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if a.state == StateAPIKey && a.apiKeyView != nil {
 			a.apiKeyView.TickSpinner()
 		}
-		if a.state == StateLocalModel && a.localModelView != nil {
-			a.localModelView.TickSpinner()
+		if a.state == StateCloning && a.cloningView != nil {
+			a.cloningView.TickSpinner()
 		}
 
 		// Update game if active
@@ -293,7 +687,11 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		}
 
-		cmds = append(cmds, tick())
+		if a.needsTick() {
+			cmds = append(cmds, tick())
+		} else {
+			a.tickRunning = false
+		}
 
 	case CountdownMsg:
 		if a.state != StateAuth {
@@ -302,7 +700,7 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		a.authCountdown = int(msg)
 		if a.authCountdown <= 0 {
 			if a.authView != nil {
-				browser.OpenURL(a.authView.GetAuthURL())
+				cmds = append(cmds, openAuthURL(a.authView.GetAuthURL()))
 				a.authView.SetAuthState(views.AuthStateWaiting)
 			}
 		} else if a.authView != nil {
@@ -310,7 +708,22 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			cmds = append(cmds, countdown(a.authCountdown-1))
 		}
 
+	case AuthBrowserMsg:
+		if a.state == StateAuth && a.authView != nil && msg.Error != nil {
+			a.authView.SetBrowserError(msg.Error)
+		}
+
+	case DashboardBrowserMsg:
+		if a.resultsView != nil && msg.Error != nil {
+			a.resultsView.SetDashboardFailed()
+		}
+
 	case AnalysisDoneMsg:
+		// The user already left (esc or ctrl+h); drop the cancelled result
+		if a.state != StateAnalyzing && a.state != StateGame {
+			break
+		}
+		a.dismissSlowPrompt()
 		err := msg.Error
 		if err == nil && msg.Result != nil && msg.Result.Error != nil {
 			err = msg.Result.Error
@@ -323,31 +736,53 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				a.game.SetProgressInfo("", true, false)
 			}
 		}
-		if err != nil {
+		if err != nil && a.configMgr.Config.Provider == "skene" && isUnauthorized(err) {
+			info := *views.ErrSkeneAuthExpired
+			info.Message += "\n\n" + err.Error()
+			a.showError(&info)
+		} else if err != nil && isModelNotFound(err) {
+			a.showModelNotFound(err)
+		} else if err != nil && isQuotaExceeded(err) {
+			a.showQuotaExceeded(err)
+		} else if err != nil && isUVError(err) {
+			a.showUVInstallError(err)
+		} else if err != nil && isPythonMissing(err) {
+			a.showPythonNotFound(err)
+		} else if err != nil {
 			suggestion := analysisErrorSuggestion(err)
+			repeated := a.recordFailure(err) >= constants.MaxIdenticalFailures
+			if repeated {
+				suggestion = fmt.Sprintf(constants.ErrorRepeatedFailure, a.failureCount)
+			}
 			a.showError(&views.ErrorInfo{
-				Code:       constants.ErrorAnalysisFailed,
-				Title:      constants.ErrorAnalysisTitle,
-				Message:    err.Error(),
-				Suggestion: suggestion,
-				Severity:   views.SeverityError,
-				Retryable:  true,
+				Code:           constants.ErrorAnalysisFailed,
+				Title:          constants.ErrorAnalysisTitle,
+				Message:        err.Error(),
+				Suggestion:     suggestion,
+				Severity:       views.SeverityError,
+				Retryable:      true,
+				Reconfigurable: repeated,
 			})
 		} else {
-			a.state = StateResults
+			a.failureKey, a.failureCount = "", 0
+			a.recordHistory()
 			if msg.Result != nil {
 				a.resultsView = views.NewResultsViewWithContent(
 					msg.Result.GrowthPlan,
 					msg.Result.Manifest,
 					msg.Result.GrowthTemplate,
 				)
+				a.resultsView.SetChanges(msg.Result.Changes)
 			} else {
 				a.resultsView = views.NewResultsView()
 			}
+			a.offerDashboard(a.configMgr.Config.Provider)
 			a.resultsView.SetSize(a.width, a.height)
+			a.replaceState(StateResults)
 		}
 
 	case AnalysisPhaseMsg:
+		a.dismissSlowPrompt()
 		if a.analyzingView != nil {
 			// Use phase name from enum instead of index
 			phaseName := msg.Update.Phase.String()
@@ -363,6 +798,7 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 	case NextStepOutputMsg:
+		a.dismissSlowPrompt()
 		if a.analyzingView != nil {
 			a.analyzingView.UpdatePhase(-1, 0, msg.Line)
 		}
@@ -371,9 +807,15 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if a.analyzingView != nil {
 			a.analyzingView.ShowPrompt(msg.Question, msg.Options)
 			a.pendingPromptResponse = msg.Response
+			a.slowPrompt = false
+		}
+		// The question would go unseen behind the game
+		if a.state == StateGame {
+			a.popState()
 		}
 
 	case NextStepDoneMsg:
+		a.dismissSlowPrompt()
 		if a.analyzingView != nil {
 			if msg.Error != nil {
 				a.analyzingView.SetCommandFailed(msg.Error.Error())
@@ -381,6 +823,9 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				a.analyzingView.SetDone()
 			}
 		}
+		if msg.Error == nil && msg.Section != "" && a.resultsView != nil {
+			a.resultsView.SetSectionContent(msg.Section, msg.Content)
+		}
 		// Update game progress if game is active
 		if a.state == StateGame && a.game != nil && a.analyzingView != nil {
 			if a.analyzingView.IsDone() {
@@ -399,15 +844,6 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				a.authView.ShowFallback()
 			}
 		} else {
-			// Auth succeeded - set the API key and model
-			a.configMgr.SetAPIKey(msg.APIKey)
-			if msg.Model != "" {
-				a.configMgr.SetModel(msg.Model)
-			} else {
-				// Default Skene model
-				a.configMgr.SetModel("skene-growth-v1")
-			}
-
 			// Show "verifying" spinner first so the user sees activity
 			if a.authView != nil {
 				a.authView.SetAuthState(views.AuthStateVerifying)
@@ -419,32 +855,132 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				a.callbackServer = nil
 			}
 
-			// After a fake verification delay, show success
-			cmds = append(cmds, tea.Tick(2*time.Second, func(t time.Time) tea.Msg {
-				return authVerifiedMsg{}
-			}))
+			cmds = append(cmds, a.checkAuthKey(msg))
 		}
 
-	case authVerifiedMsg:
-		// Show success state after the fake verification delay
-		if a.authView != nil {
-			a.authView.SetAuthState(views.AuthStateSuccess)
+	case authKeyCheckedMsg:
+		// Superseded, already handled, or the user left the auth screen
+		if msg.ID != a.authCheckID || a.state != StateAuth || a.authView == nil || a.authView.IsFallbackShown() {
+			break
+		}
+		a.authCheckID++
+		if msg.Err != nil {
+			a.authView.ShowKeyRejected(msg.Err)
+			break
+		}
+
+		// Auth succeeded - set the API key and model
+		a.configMgr.SetAPIKey(msg.APIKey)
+		a.keyProvider = a.configMgr.Config.Provider
+		// Signing in again keeps the model the analysis was configured with
+		if !a.reauth {
+			if msg.Model != "" {
+				a.configMgr.SetModel(msg.Model)
+			} else {
+				// Default Skene model
+				a.configMgr.SetModel("skene-growth-v1")
+			}
 		}
+		a.authView.SetAuthState(views.AuthStateSuccess)
 		// Transition to project directory after showing success briefly
 		cmds = append(cmds, tea.Tick(1500*time.Millisecond, func(t time.Time) tea.Msg {
 			return authSuccessTransitionMsg{}
 		}))
 
 	case authSuccessTransitionMsg:
-		a.transitionToProjectDir()
+		a.authenticated = true
+		// The auth screen is done with; esc from here goes back to providers
+		if a.state == StateAuth {
+			a.popState()
+		}
+		if a.reauth {
+			a.reauth = false
+			a.applyAnalysisConfig()
+			cmds = append(cmds, a.startAnalysis())
+		} else if a.selectedProvider != nil && len(a.selectedProvider.Models) > 1 {
+			// Let the user pick, starting from the server-suggested model
+			a.modelView = views.NewModelView(a.selectedProvider)
+			a.modelView.SelectModel(a.configMgr.Config.Model)
+			a.modelView.SetSize(a.width, a.height)
+			a.pushState(StateModelSelect)
+		} else {
+			a.selectedModel = config.GetModelByID(a.configMgr.Config.Provider, a.configMgr.Config.Model)
+			cmds = append(cmds, a.transitionToProjectDir())
+		}
 
-	case LocalModelDetectMsg:
-		if a.localModelView != nil {
-			if msg.Error != nil {
-				a.localModelView.SetError(msg.Error.Error())
+	case ModelsFetchedMsg:
+		if a.modelView == nil || a.selectedProvider == nil || a.selectedProvider.ID != msg.ProviderID {
+			break
+		}
+		if len(msg.Models) > 0 {
+			// A failure to write the cache still shows the fetched list
+			a.modelView.SetModels(msg.Models, time.Now())
+		} else {
+			a.modelView.SetStatus(fmt.Sprintf(constants.ModelListFetchFailed, msg.Err))
+		}
+
+	case UVInstallProgressMsg:
+		if msg.View == a.errorView && a.state == StateError {
+			a.errorView.SetStatus(uvInstallProgress(msg.Done, msg.Total))
+		}
+
+	case UVInstallDoneMsg:
+		if msg.View != a.errorView || a.state != StateError {
+			break
+		}
+		a.errorView.SetInstalling(false)
+		if msg.Err != nil {
+			a.errorView.SetStatus(fmt.Sprintf(constants.UVInstallFailedFmt, msg.Err))
+			break
+		}
+		a.popState()
+		if isTransientState(a.state) {
+			a.navigateBackFromAnalyzing()
+			a.applyAnalysisConfig()
+			cmds = append(cmds, a.startAnalysis())
+		}
+
+	case CloneProgressMsg:
+		if msg.View == a.cloningView && a.cloningView != nil {
+			a.cloningView.SetProgress(msg.Progress.Stage, msg.Progress.Fraction)
+		}
+
+	case CloneDoneMsg:
+		// The clone was cancelled; drop whatever it left behind
+		if msg.View != a.cloningView || a.state != StateCloning {
+			gitclone.Remove(msg.Dir)
+			break
+		}
+		a.cancelFunc = nil
+		a.cloningView = nil
+		a.popState()
+		if msg.Err != nil {
+			info := *views.ErrCloneFailed
+			if msg.GitMissing {
+				info = *views.ErrGitNotFound
 			} else {
-				a.localModelView.SetModels(msg.Models)
+				info.Message = msg.Err.Error()
 			}
+			a.showError(&info)
+			break
+		}
+		a.remote.dir = msg.Dir
+		a.presetProjectDir = msg.Dir
+		cmds = append(cmds, a.transitionToProjectDir())
+
+	case RepoSizeMsg:
+		if a.analysisConfigView != nil && a.analysisConfigView.ProjectDir() == msg.ProjectDir {
+			a.analysisConfigView.SetRepoSize(msg.Size.Files, msg.Size.Truncated)
+		}
+
+	case ProviderHealthMsg:
+		if a.providerView != nil {
+			a.providerView.SetProviderHealth(msg.ProviderID, msg.Reachable)
+		}
+
+	case UpdateCheckMsg:
+		if a.welcomeView != nil {
+			a.welcomeView.SetUpdateAvailable(msg.Latest)
 		}
 
 	case game.GameTickMsg:
@@ -454,6 +990,12 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 	default:
+		// Clipboard reads started by ctrl+v in the API key field
+		if a.state == StateAPIKey && a.apiKeyView != nil {
+			if cmd := a.apiKeyView.Update(msg); cmd != nil {
+				cmds = append(cmds, cmd)
+			}
+		}
 		// Forward messages to welcome animation
 		if a.state == StateWelcome && a.welcomeView != nil {
 			animCmd := a.welcomeView.UpdateAnimation(msg)
@@ -463,6 +1005,12 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 	}
 
+	// Resume the global tick on entering a screen that needs it
+	if !a.tickRunning && a.needsTick() {
+		a.tickRunning = true
+		cmds = append(cmds, tick())
+	}
+
 	return a, tea.Batch(cmds...)
 }
 
@@ -473,6 +1021,14 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 func (a *App) handleKeyPress(msg tea.KeyMsg) tea.Cmd {
 	key := msg.String()
 
+	// Only esc works on a screen that is still being built
+	if !a.hasView(a.state) {
+		if a.keys.Action(key, false) == keymap.Back {
+			a.popState()
+		}
+		return nil
+	}
+
 	switch a.state {
 	case StateWelcome:
 		return a.handleWelcomeKeys(key)
@@ -484,14 +1040,12 @@ func (a *App) handleKeyPress(msg tea.KeyMsg) tea.Cmd {
 		return a.handleAuthKeys(key)
 	case StateAPIKey:
 		return a.handleAPIKeyKeys(msg)
-	case StateLocalModel:
-		return a.handleLocalModelKeys(key)
 	case StateProjectDir:
 		return a.handleProjectDirKeys(msg)
 	case StateAnalysisConfig:
-		return a.handleAnalysisConfigKeys(key)
+		return a.handleAnalysisConfigKeys(msg)
 	case StateAnalyzing:
-		return a.handleAnalyzingKeys(key)
+		return a.handleAnalyzingKeys(msg)
 	case StateResults:
 		return a.handleResultsKeys(key)
 	case StateNextSteps:
@@ -500,56 +1054,145 @@ func (a *App) handleKeyPress(msg tea.KeyMsg) tea.Cmd {
 		return a.handleErrorKeys(key)
 	case StateGame:
 		return a.handleGameKeys(msg)
+	case StateCloning:
+		return a.handleCloningKeys(key)
+	case StateHistory:
+		return a.handleHistoryKeys(key)
 	}
 
 	return nil
 }
 
 func (a *App) handleWelcomeKeys(key string) tea.Cmd {
-	switch key {
-	case "enter":
+	action := a.keys.Action(key, false)
+	switch {
+	case action == keymap.Select:
+		if (a.presetProjectDir != "" || a.remote != nil) && a.restoreConfiguredSelection() {
+			return a.transitionToProjectDir()
+		}
 		// Skip system checks and installation, go straight to provider selection
-		a.state = StateProviderSelect
+		a.providerView.SelectProvider(a.configMgr.Config.Provider)
+		a.pushState(StateProviderSelect)
 		a.providerView.SetSize(a.width, a.height)
-		return nil
+		return a.checkProviderHealth()
+	case a.keys.Is(key, keymap.UseSaved):
+		// Reuse the saved provider and model and go straight to the project
+		if a.configMgr.HasValidConfig() && a.restoreConfiguredSelection() {
+			return a.transitionToProjectDir()
+		}
+	case a.keys.Is(key, keymap.History):
+		a.historyView = views.NewHistoryView(history.Load())
+		a.historyView.SetSize(a.width, a.height)
+		a.pushState(StateHistory)
 	}
 	return nil
 }
 
+// refreshSavedConfig offers the saved provider/model on the welcome screen
+// when it is complete enough to skip the wizard
+func (a *App) refreshSavedConfig() {
+	label := ""
+	cfg := a.configMgr.Config
+	if a.configMgr.HasValidConfig() {
+		if provider := config.GetProviderByID(cfg.Provider); provider != nil {
+			model := cfg.Model
+			if m := config.GetModelByID(cfg.Provider, cfg.Model); m != nil {
+				model = m.Name
+			}
+			label = provider.Name + " / " + model
+		}
+	}
+	a.welcomeView.SetSavedConfig(label)
+}
+
 func (a *App) handleProviderKeys(msg tea.KeyMsg) tea.Cmd {
-	key := msg.String()
-	switch key {
-	case "up", "k":
+	action := a.keys.Action(msg.String(), false)
+	switch {
+	case action == keymap.Up:
 		a.providerView.HandleUp()
-	case "down", "j":
+	case action == keymap.Down:
 		a.providerView.HandleDown()
-	case "enter":
+	case action == keymap.Select:
 		return a.selectProvider()
-	case "esc":
-		a.state = StateWelcome
-		return a.welcomeView.ResetAnimation()
+	case action == keymap.Back:
+		a.popState()
+		if a.state == StateWelcome {
+			return a.welcomeView.ResetAnimation()
+		}
 	}
 	return nil
 }
 
 func (a *App) handleModelKeys(msg tea.KeyMsg) tea.Cmd {
-	key := msg.String()
-	switch key {
-	case "up", "k":
+	action := a.keys.Action(msg.String(), false)
+	switch {
+	case action == keymap.Up:
 		a.modelView.HandleUp()
-	case "down", "j":
+	case action == keymap.Down:
 		a.modelView.HandleDown()
-	case "enter":
-		a.selectModel()
-	case "esc":
-		a.state = StateProviderSelect
+	case action == keymap.Select:
+		return a.selectModel()
+	case a.keys.Is(msg.String(), keymap.FetchModels):
+		return a.fetchModels()
+	case action == keymap.Back:
+		a.popState()
 	}
 	return nil
 }
 
+// fetchModels asks the selected provider for its current models, using
+// the key typed on the API key screen or the configured one
+func (a *App) fetchModels() tea.Cmd {
+	provider := a.selectedProvider
+	if provider == nil || a.modelView == nil || a.modelView.IsFetching() {
+		return nil
+	}
+	if provider.ID == "skene" {
+		a.modelView.SetStatus(fmt.Sprintf(constants.ModelListUnsupported, provider.Name))
+		return nil
+	}
+
+	apiKey, baseURL := "", a.configMgr.Config.GenericBaseURL
+	if a.apiKeyView != nil && a.apiKeyView.ProviderID() == provider.ID {
+		apiKey = a.apiKeyView.GetAPIKey()
+		if url := a.apiKeyView.GetBaseURL(); url != "" {
+			baseURL = url
+		}
+	}
+	if apiKey == "" && a.keyProvider == provider.ID {
+		apiKey = a.configMgr.Config.APIKey
+	}
+	if apiKey == "" {
+		a.modelView.SetStatus(fmt.Sprintf(constants.ModelListNeedsKey, provider.Name))
+		return nil
+	}
+
+	a.modelView.SetFetching()
+	providerID := provider.ID
+	insecure := a.configMgr.Config.InsecureSkipVerify
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), constants.HTTPTimeout)
+		defer cancel()
+		models, err := modelcatalog.Fetch(ctx, providerID, apiKey, baseURL, insecure)
+		if err == nil {
+			err = config.SaveCachedModels(providerID, models)
+		}
+		return ModelsFetchedMsg{ProviderID: providerID, Models: models, Err: err}
+	}
+}
+
 func (a *App) handleAuthKeys(key string) tea.Cmd {
-	switch key {
-	case "m":
+	action := a.keys.Action(key, false)
+	switch {
+	case a.keys.Is(key, keymap.Copy):
+		if a.authView != nil && !a.authView.IsFallbackShown() {
+			if err := clipboard.WriteAll(a.authView.GetAuthURL()); err != nil {
+				a.authView.SetStatus(fmt.Sprintf(constants.AuthURLCopyFailed, err))
+			} else {
+				a.authView.SetStatus(constants.AuthURLCopied)
+			}
+		}
+	case a.keys.Is(key, keymap.ManualEntry):
 		// Skip to manual entry - shutdown callback server
 		if a.callbackServer != nil {
 			a.callbackServer.Shutdown()
@@ -558,91 +1201,93 @@ func (a *App) handleAuthKeys(key string) tea.Cmd {
 		if a.authView != nil {
 			a.authView.ShowFallback()
 		}
-	case "enter":
+	case action == keymap.Select:
 		if a.authView != nil && a.authView.IsFallbackShown() {
 			a.transitionToAPIKey()
+		} else if a.authView != nil && a.authView.IsRemote() {
+			if a.callbackServer != nil {
+				a.callbackServer.Shutdown()
+				a.callbackServer = nil
+			}
+			a.transitionToAPIKey()
 		}
-	case "esc":
+	case action == keymap.Back:
 		// Clean up callback server
 		if a.callbackServer != nil {
 			a.callbackServer.Shutdown()
 			a.callbackServer = nil
 		}
-		a.state = StateProviderSelect
+		a.reauth = false
+		a.popState()
 	}
 	return nil
 }
 
 func (a *App) handleAPIKeyKeys(msg tea.KeyMsg) tea.Cmd {
 	key := msg.String()
+	action := a.keys.Action(key, true)
 
-	switch key {
-	case "enter":
+	switch {
+	case action == keymap.Select:
 		if a.apiKeyView.Validate() {
 			a.configMgr.SetAPIKey(a.apiKeyView.GetAPIKey())
+			a.keyProvider = a.apiKeyView.ProviderID()
 			if a.apiKeyView.GetBaseURL() != "" {
 				a.configMgr.SetBaseURL(a.apiKeyView.GetBaseURL())
+				a.configMgr.Config.GenericBaseURL = a.apiKeyView.GetBaseURL()
 			}
-			a.transitionToProjectDir()
+			a.configMgr.SetInsecureSkipVerify(a.apiKeyView.GetInsecure())
+			return a.transitionToProjectDir()
 		}
-	case "tab":
+	case key == "tab":
 		a.apiKeyView.HandleTab()
-	case "esc":
-		a.navigateBackFromAPIKey()
+	case a.keys.Is(key, keymap.RevealKey):
+		a.apiKeyView.ToggleReveal()
+	case action == keymap.Up || action == keymap.Down:
+		if a.apiKeyView.IsBaseURLFocused() {
+			if action == keymap.Up {
+				a.apiKeyView.CyclePreset(-1)
+			} else {
+				a.apiKeyView.CyclePreset(1)
+			}
+		}
+	case a.keys.Is(key, keymap.Toggle):
+		if a.apiKeyView.IsInsecureFocused() {
+			a.apiKeyView.ToggleInsecure()
+		} else {
+			return a.apiKeyView.Update(msg)
+		}
+	case action == keymap.Back:
+		a.popState()
 	default:
-		a.apiKeyView.Update(msg)
-	}
-	return nil
-}
-
-func (a *App) handleLocalModelKeys(key string) tea.Cmd {
-	if a.localModelView == nil {
-		return nil
-	}
-
-	switch key {
-	case "up", "k":
-		a.localModelView.HandleUp()
-	case "down", "j":
-		a.localModelView.HandleDown()
-	case "enter":
-		if a.localModelView.IsFound() {
-			model := a.localModelView.GetSelectedModel()
-			a.configMgr.SetModel(model)
-			a.configMgr.SetBaseURL(a.localModelView.GetBaseURL())
-			a.transitionToProjectDir()
-		}
-	case "r":
-		// Retry detection
-		return a.detectLocalModels()
-	case "esc":
-		a.state = StateProviderSelect
+		return a.apiKeyView.Update(msg)
 	}
 	return nil
 }
 
 func (a *App) handleProjectDirKeys(msg tea.KeyMsg) tea.Cmd {
 	key := msg.String()
+	action := a.keys.Action(key, a.projectDirView.IsInputFocused())
 
 	// Handle existing analysis choice prompt
 	if a.projectDirView.IsAskingExistingChoice() {
-		switch key {
-		case "left", "h":
+		switch {
+		case action == keymap.Left:
 			a.projectDirView.HandleLeft()
-		case "right", "l":
+		case action == keymap.Right:
 			a.projectDirView.HandleRight()
-		case "enter":
+		case action == keymap.Select:
 			choice := a.projectDirView.GetExistingChoiceLabel()
-			a.configMgr.SetProjectDir(a.projectDirView.GetProjectDir())
+			a.useProject(a.projectDirView.GetProjectDir())
 			switch choice {
 			case constants.ProjectDirViewAnalysis:
 				a.projectDirView.SetExistingChoice(true)
 				a.transitionToResultsFromExisting()
 			case constants.ProjectDirRerunAnalysis:
 				a.projectDirView.SetExistingChoice(false)
-				a.transitionToAnalysisConfig()
+				return a.transitionToAnalysisConfig()
 			}
-		case "esc":
+		case action == keymap.Back:
 			a.projectDirView.DismissExistingChoice()
 		}
 		return nil
@@ -651,23 +1296,31 @@ func (a *App) handleProjectDirKeys(msg tea.KeyMsg) tea.Cmd {
 	// Handle browsing mode
 	if a.projectDirView.IsBrowsing() {
 		if a.projectDirView.BrowseFocusOnList() {
-			switch key {
-			case "up", "k", "down", "j", "backspace", ".":
-				a.projectDirView.HandleBrowseKey(key)
-			case "enter":
-				a.projectDirView.HandleBrowseKey(key)
-			case "tab":
+			switch {
+			case action == keymap.Up:
+				a.projectDirView.BrowseUp()
+			case action == keymap.Down:
+				a.projectDirView.BrowseDown()
+			case a.keys.Is(key, keymap.ParentDir):
+				a.projectDirView.BrowseParent()
+			case a.keys.Is(key, keymap.ToggleHidden):
+				a.projectDirView.ToggleHidden()
+			case action == keymap.Select:
+				a.projectDirView.BrowseEnter()
+			case key == "tab":
 				a.projectDirView.HandleBrowseTab()
-			case "esc":
+			case action == keymap.Back:
 				a.projectDirView.StopBrowsing()
 			}
 		} else {
-			switch key {
-			case "left", "h":
+			switch {
+			case action == keymap.Left:
 				a.projectDirView.HandleBrowseLeft()
-			case "right", "l":
+			case action == keymap.Right:
 				a.projectDirView.HandleBrowseRight()
-			case "enter":
+			case a.keys.Is(key, keymap.ToggleHidden):
+				a.projectDirView.ToggleHidden()
+			case action == keymap.Select:
 				btn := a.projectDirView.GetBrowseButtonLabel()
 				switch btn {
 				case constants.ButtonSelectDir:
@@ -675,9 +1328,9 @@ func (a *App) handleProjectDirKeys(msg tea.KeyMsg) tea.Cmd {
 				case constants.ButtonCancel:
 					a.projectDirView.StopBrowsing()
 				}
-			case "tab":
+			case key == "tab":
 				a.projectDirView.HandleBrowseTab()
-			case "esc":
+			case action == keymap.Back:
 				a.projectDirView.StopBrowsing()
 			}
 		}
@@ -685,30 +1338,25 @@ func (a *App) handleProjectDirKeys(msg tea.KeyMsg) tea.Cmd {
 	}
 
 	if a.projectDirView.IsInputFocused() {
-		switch key {
-		case "enter":
+		switch {
+		case action == keymap.Select:
 			if a.projectDirView.IsValid() {
-				// Check for existing analysis first
-				if a.projectDirView.CheckForExistingAnalysis() {
-					return nil
-				}
-				a.configMgr.SetProjectDir(a.projectDirView.GetProjectDir())
-				a.transitionToAnalysisConfig()
+				return a.continueWithProject()
 			}
-		case "tab":
+		case key == "tab":
 			a.projectDirView.HandleTab()
-		case "esc":
-			a.navigateBackFromProjectDir()
+		case action == keymap.Back:
+			a.popState()
 		default:
 			a.projectDirView.Update(msg)
 		}
 	} else {
-		switch key {
-		case "left", "h":
+		switch {
+		case action == keymap.Left:
 			a.projectDirView.HandleLeft()
-		case "right", "l":
+		case action == keymap.Right:
 			a.projectDirView.HandleRight()
-		case "enter":
+		case action == keymap.Select:
 			btn := a.projectDirView.GetButtonLabel()
 			switch btn {
 			case constants.ButtonUseCurrent:
@@ -717,70 +1365,110 @@ func (a *App) handleProjectDirKeys(msg tea.KeyMsg) tea.Cmd {
 				a.projectDirView.StartBrowsing()
 			case constants.ButtonContinue:
 				if a.projectDirView.IsValid() {
-					// Check for existing analysis first
-					if a.projectDirView.CheckForExistingAnalysis() {
-						return nil
-					}
-					a.configMgr.SetProjectDir(a.projectDirView.GetProjectDir())
-					a.transitionToAnalysisConfig()
+					return a.continueWithProject()
 				}
 			}
-		case "tab":
+		case key == "tab":
 			a.projectDirView.HandleTab()
-		case "esc":
-			a.navigateBackFromProjectDir()
+		case action == keymap.Back:
+			a.popState()
 		}
 	}
 	return nil
 }
 
-func (a *App) handleAnalysisConfigKeys(key string) tea.Cmd {
-	switch key {
-	case "enter":
-		a.applyAnalysisConfig()
+// continueWithProject moves on from the chosen project directory, asking
+// about an existing analysis first if there is one
+func (a *App) continueWithProject() tea.Cmd {
+	a.useProject(a.projectDirView.GetProjectDir())
+	if a.projectDirView.CheckForExistingAnalysis() {
+		return nil
+	}
+	return a.transitionToAnalysisConfig()
+}
+
+func (a *App) handleAnalysisConfigKeys(msg tea.KeyMsg) tea.Cmd {
+	key := msg.String()
+	action := a.keys.Action(key, false)
+
+	switch {
+	case a.keys.Is(key, keymap.Toggle):
+		a.analysisConfigView.ToggleDocs()
+	case action == keymap.Select:
+		a.applyAnalysisConfig()
 		return a.startAnalysis()
-	case "esc":
-		a.state = StateProjectDir
+	case action == keymap.Back:
+		a.popState()
 	}
 	return nil
 }
 
-func (a *App) handleAnalyzingKeys(key string) tea.Cmd {
+func (a *App) handleAnalyzingKeys(msg tea.KeyMsg) tea.Cmd {
+	key := msg.String()
+	searching := a.analyzingView != nil && a.analyzingView.IsSearching()
+	action := a.keys.Action(key, searching)
+
+	if searching {
+		switch {
+		case action == keymap.Select:
+			a.analyzingView.ConfirmSearch()
+		case action == keymap.Back:
+			a.analyzingView.ClearSearch()
+		default:
+			a.analyzingView.UpdateSearch(msg)
+		}
+		return nil
+	}
+
 	if a.analyzingView != nil && a.analyzingView.IsPromptActive() {
-		switch key {
-		case "up", "k":
+		switch {
+		case action == keymap.Up:
 			a.analyzingView.HandlePromptUp()
-		case "down", "j":
+		case action == keymap.Down:
 			a.analyzingView.HandlePromptDown()
-		case "enter":
-			idx := a.analyzingView.GetSelectedOptionIndex()
-			a.analyzingView.DismissPrompt()
-			if a.pendingPromptResponse != nil {
-				a.pendingPromptResponse <- fmt.Sprintf("%d", idx)
-				a.pendingPromptResponse = nil
+		case action == keymap.Select:
+			a.answerPrompt()
+		case len(key) == 1 && key >= "1" && key <= "9":
+			if a.analyzingView.SelectPromptOption(int(key[0] - '0')) {
+				a.answerPrompt()
 			}
+		case action == keymap.Back:
+			// The engine is blocked on the answer; cancelling unblocks it
+			a.analyzingView.DismissPrompt()
+			a.pendingPromptResponse = nil
+			a.slowPrompt = false
+			return a.handleAnalyzingKeys(msg)
 		}
 		return nil
 	}
 
-	switch key {
-	case "up", "k":
+	switch {
+	case a.keys.Is(key, keymap.Search) && a.analyzingView != nil && a.analyzingView.CanSearch():
+		a.analyzingView.StartSearch()
+	case a.keys.Is(key, keymap.NextMatch) && a.analyzingView != nil && a.analyzingView.HasSearch():
+		a.analyzingView.NextMatch()
+	case a.keys.Is(key, keymap.PrevMatch) && a.analyzingView != nil && a.analyzingView.HasSearch():
+		a.analyzingView.PrevMatch()
+	case action == keymap.Back && a.analyzingView != nil && a.analyzingView.HasSearch():
+		a.analyzingView.ClearSearch()
+	case a.keys.Is(key, keymap.Timestamps) && a.analyzingView != nil:
+		a.analyzingView.ToggleTimestamps()
+	case action == keymap.Up:
 		if a.analyzingView != nil {
 			a.analyzingView.ScrollUp(3)
 		}
-	case "down", "j":
+	case action == keymap.Down:
 		if a.analyzingView != nil {
 			a.analyzingView.ScrollDown(3)
 		}
-	case "g":
+	case action == keymap.Game && a.configMgr.Config.MiniGameEnabled:
 		if a.analyzingView != nil && !a.analyzingView.IsDone() {
-			a.prevState = a.state
-			a.state = StateGame
 			if a.game == nil {
 				a.game = game.NewGame(60, 20)
 			} else {
 				a.game.Restart()
 			}
+			a.pushState(StateGame)
 			a.game.SetSize(60, 20)
 			currentPhase := a.analyzingView.GetCurrentPhase()
 			if currentPhase == "" {
@@ -789,7 +1477,7 @@ func (a *App) handleAnalyzingKeys(key string) tea.Cmd {
 			a.game.SetProgressInfo(currentPhase, false, false)
 			return game.GameTickCmd()
 		}
-	case "esc":
+	case action == keymap.Back:
 		if a.analyzingView == nil {
 			return nil
 		}
@@ -808,44 +1496,146 @@ func (a *App) handleAnalyzingKeys(key string) tea.Cmd {
 	return nil
 }
 
+// answerPrompt sends the selected option's number to the waiting engine
+func (a *App) answerPrompt() {
+	idx := a.analyzingView.GetSelectedOptionIndex()
+	a.analyzingView.DismissPrompt()
+	if a.slowPrompt {
+		a.slowPrompt = false
+		if idx == 2 {
+			if a.cancelFunc != nil {
+				a.cancelFunc()
+				a.cancelFunc = nil
+			}
+			a.navigateBackFromAnalyzing()
+		} else {
+			a.slowSnoozed = time.Now()
+			if a.keepWaiting != nil {
+				a.keepWaiting()
+			}
+		}
+		return
+	}
+	if a.pendingPromptResponse != nil {
+		a.pendingPromptResponse <- fmt.Sprintf("%d", idx)
+		a.pendingPromptResponse = nil
+	}
+}
+
+// checkSlowProvider asks whether to keep waiting once the running command
+// has been silent for constants.ProviderSlowTimeout, and again after each
+// further timeout the user chose to wait through
+func (a *App) checkSlowProvider() {
+	v := a.analyzingView
+	if v.IsDone() || v.HasFailed() || v.IsPromptActive() {
+		return
+	}
+	quiet := v.QuietFor()
+	if quiet < constants.ProviderSlowTimeout || time.Since(a.slowSnoozed) < constants.ProviderSlowTimeout {
+		return
+	}
+	v.ShowPrompt(
+		fmt.Sprintf(constants.ProviderSlowQuestion, quiet.Round(time.Second)),
+		[]string{constants.ProviderSlowKeepWaiting, constants.ProviderSlowCancel},
+	)
+	a.slowPrompt = true
+}
+
+// dismissSlowPrompt takes the keep-waiting question down once the command
+// prints something or finishes
+func (a *App) dismissSlowPrompt() {
+	if a.slowPrompt && a.analyzingView != nil {
+		a.analyzingView.DismissPrompt()
+	}
+	a.slowPrompt = false
+}
+
 func (a *App) handleResultsKeys(key string) tea.Cmd {
-	switch key {
-	case "left", "h":
+	action := a.keys.Action(key, false)
+	switch {
+	case action == keymap.Left:
 		a.resultsView.HandleLeft()
-	case "right", "l":
+	case action == keymap.Right:
 		a.resultsView.HandleRight()
-	case "up", "k":
+	case action == keymap.Up:
 		a.resultsView.HandleUp()
-	case "down", "j":
+	case action == keymap.Down:
 		a.resultsView.HandleDown()
-	case "tab":
+	case key == "pgup":
+		a.resultsView.HandlePageUp()
+	case key == "pgdown":
+		a.resultsView.HandlePageDown()
+	case key == "home":
+		a.resultsView.HandleHome()
+	case key == "end":
+		a.resultsView.HandleEnd()
+	case key == "tab":
 		a.resultsView.HandleTab()
-	case "n", "enter":
-		a.state = StateNextSteps
+	case a.keys.Is(key, keymap.RawPlan):
+		a.resultsView.ToggleRawPlan()
+	case a.keys.Is(key, keymap.Contents):
+		a.resultsView.ToggleTOC()
+	case action == keymap.Select && a.resultsView.IsTOCOpen():
+		a.resultsView.ToggleTOC()
+	case a.keys.Is(key, keymap.Regenerate) && a.resultsView.CanRegenerate():
+		a.confirmRegenerate = true
+	case a.keys.Is(key, keymap.Dashboard) && a.resultsView.DashboardURL() != "":
+		if !auth.CanOpenBrowser() {
+			a.resultsView.SetDashboardFailed()
+			return nil
+		}
+		return openDashboardURL(a.resultsView.DashboardURL())
+	case a.keys.Is(key, keymap.NextSteps) || action == keymap.Select:
 		a.nextStepsView = views.NewNextStepsView()
+		a.nextStepsView.SetOutputFiles(a.outputFiles())
+		a.pushState(StateNextSteps)
 		a.nextStepsView.SetSize(a.width, a.height)
 	}
 	return nil
 }
 
 func (a *App) handleNextStepsKeys(key string) tea.Cmd {
-	switch key {
-	case "up", "k":
+	action := a.keys.Action(key, false)
+	if a.nextStepsView.IsFilesFocused() {
+		path := a.nextStepsView.GetSelectedFile()
+		switch {
+		case action == keymap.Select || a.keys.Is(key, keymap.Open):
+			if path != "" {
+				browser.OpenFile(path)
+			}
+			return nil
+		case a.keys.Is(key, keymap.Copy):
+			if path == "" {
+				return nil
+			}
+			if err := clipboard.WriteAll(path); err != nil {
+				a.nextStepsView.SetStatus(fmt.Sprintf(constants.NextStepsCopyFailed, err))
+			} else {
+				a.nextStepsView.SetStatus(fmt.Sprintf(constants.NextStepsCopied, path))
+			}
+			return nil
+		}
+	}
+
+	switch {
+	case key == "tab":
+		a.nextStepsView.HandleTab()
+	case action == keymap.Up:
 		a.nextStepsView.HandleUp()
-	case "down", "j":
+	case action == keymap.Down:
 		a.nextStepsView.HandleDown()
-	case "enter":
-		action := a.nextStepsView.GetSelectedAction()
-		if action == nil {
+	case action == keymap.Select:
+		step := a.nextStepsView.GetSelectedAction()
+		if step == nil {
 			return nil
 		}
-		switch action.ID {
+		switch step.ID {
 		case "exit":
-			return tea.Quit
+			return a.quit()
 		case "rerun":
 			return a.startAnalysis()
 		case "config":
-			a.state = StateProviderSelect
+			a.pushState(StateProviderSelect)
 		case "plan":
 			return a.runEngineCommand("Generating Growth Plan", "plan")
 		case "build":
@@ -853,63 +1643,279 @@ func (a *App) handleNextStepsKeys(key string) tea.Cmd {
 		case "validate":
 			return a.runEngineCommand("Validating Manifest", "validate")
 		case "open":
-			projectDir := a.configMgr.Config.ProjectDir
-			if projectDir == "" {
-				projectDir, _ = os.Getwd()
-			}
-			outputDir := filepath.Join(projectDir, constants.OutputDirName)
-			browser.OpenURL(outputDir)
-		}
-	case "esc":
+			browser.OpenURL(a.outputDir())
+		case "save-project":
+			a.saveConfig(a.configMgr.SaveConfig, &a.configMgr.ProjectConfigPath)
+		case "save-project-yaml":
+			a.saveConfig(func() error { return a.configMgr.SaveConfigAs(config.FormatYAML) }, &a.configMgr.ProjectConfigPath)
+		case "save-user":
+			a.saveConfig(a.configMgr.SaveUserConfig, &a.configMgr.UserConfigPath)
+		}
+	case action == keymap.Back:
 		a.refreshResultsView()
-		a.state = StateResults
+		a.popState()
+	}
+	return nil
+}
+
+// saveConfig writes the current settings with save and reports the result
+// on the next steps screen. path is read after saving, since a save can
+// pick the file name.
+func (a *App) saveConfig(save func() error, path *string) {
+	if err := save(); err != nil {
+		a.nextStepsView.SetStatus(fmt.Sprintf(constants.NextStepsSaveFailed, err))
+		return
+	}
+	status := fmt.Sprintf(constants.NextStepsSaved, config.GetShortenedPath(*path, constants.StatusLinePathMax))
+	if a.configMgr.KeyWithheld() {
+		status += constants.NextStepsKeyWithheld
+	}
+	a.nextStepsView.SetStatus(status)
+}
+
+func (a *App) handleHistoryKeys(key string) tea.Cmd {
+	action := a.keys.Action(key, false)
+	entry := a.historyView.GetSelectedEntry()
+	switch {
+	case action == keymap.Up:
+		a.historyView.HandleUp()
+	case action == keymap.Down:
+		a.historyView.HandleDown()
+	case action == keymap.Select:
+		if entry != nil {
+			a.showHistoryResults(*entry)
+		}
+	case a.keys.Is(key, keymap.Open):
+		if entry != nil {
+			browser.OpenURL(entry.OutputDir)
+		}
+	case action == keymap.Back:
+		a.popState()
+	}
+	return nil
+}
+
+// showHistoryResults opens the results dashboard on the files a past
+// analysis left in its output directory. The clone of a URL is gone, so
+// only a local project becomes the current one.
+func (a *App) showHistoryResults(entry history.Entry) {
+	if !gitclone.IsRemoteURL(entry.ProjectDir) {
+		a.useProject(entry.ProjectDir)
+	}
+	names := growth.OutputNames(entry.OutputFiles)
+	a.resultsView = views.NewResultsViewWithContent(
+		loadFileContent(filepath.Join(entry.OutputDir, names.File(constants.ArtifactGrowthPlan))),
+		loadFileContent(filepath.Join(entry.OutputDir, names.File(constants.ArtifactGrowthManifest))),
+		loadFileContent(filepath.Join(entry.OutputDir, names.File(constants.ArtifactGrowthTemplate))),
+	)
+	a.offerDashboard(entry.Provider)
+	a.resultsView.SetSize(a.width, a.height)
+	a.pushState(StateResults)
+}
+
+// recordHistory adds the analysis that just finished to the history file.
+// Analyses of a URL are recorded under the URL, not the temporary clone.
+func (a *App) recordHistory() {
+	cfg := a.buildEngineConfig()
+	project := cfg.ProjectDir
+	if a.remote != nil {
+		project = a.remote.url
+	}
+	history.Record(history.Entry{
+		ProjectDir:  project,
+		OutputDir:   cfg.OutputDir,
+		Provider:    cfg.Provider,
+		Model:       cfg.Model,
+		OutputFiles: cfg.OutputFiles,
+	})
+}
+
+func (a *App) handleCloningKeys(key string) tea.Cmd {
+	if a.keys.Action(key, false) == keymap.Back {
+		// The clone removes its directory once git exits
+		if a.cancelFunc != nil {
+			a.cancelFunc()
+			a.cancelFunc = nil
+		}
+		a.cloningView = nil
+		a.popState()
 	}
 	return nil
 }
 
 func (a *App) handleErrorKeys(key string) tea.Cmd {
-	switch key {
-	case "left", "h":
+	action := a.keys.Action(key, false)
+	switch {
+	case action == keymap.Left:
 		a.errorView.HandleLeft()
-	case "right", "l":
+	case action == keymap.Right:
 		a.errorView.HandleRight()
-	case "enter":
+	case action == keymap.Select:
 		btn := a.errorView.GetSelectedButton()
 		switch btn {
-		case "Retry":
-			a.state = a.prevState
-		case "Go Back":
+		case constants.ButtonRetry:
+			a.popState()
+			if a.currentError.Code == views.ErrCloneFailed.Code {
+				return a.startClone()
+			}
+			// A failed analysis is rerun rather than shown again
+			if isTransientState(a.state) {
+				a.navigateBackFromAnalyzing()
+				a.applyAnalysisConfig()
+				return a.startAnalysis()
+			}
+		case views.UseModelLabel(a.currentError.SuggestedModel):
+			a.configMgr.SetModel(a.currentError.SuggestedModel)
+			a.selectedModel = config.GetModelByID(a.configMgr.Config.Provider, a.currentError.SuggestedModel)
+			a.popState()
+			if isTransientState(a.state) {
+				a.navigateBackFromAnalyzing()
+				a.applyAnalysisConfig()
+				return a.startAnalysis()
+			}
+		case constants.ButtonReauth:
+			a.popState()
+			a.navigateBackFromAnalyzing()
+			a.selectedProvider = config.GetProviderByID("skene")
+			cmd := a.startSkeneAuth(a.selectedProvider)
+			a.reauth = a.state == StateAuth
+			return cmd
+		case constants.ButtonInstallUV:
+			return a.installUV()
+		case constants.ButtonInstallCmd:
+			a.copyInstallCommand()
+		case constants.ButtonCopyError:
+			a.copyErrorDetails()
+		case constants.ButtonReconfig:
+			a.pushState(StateProviderSelect)
+			a.providerView.SetSize(a.width, a.height)
+		case constants.ButtonGoBack:
 			a.navigateBackFromError()
-		case "Quit":
-			return tea.Quit
+		case constants.ButtonQuit:
+			return a.quit()
 		}
-	case "esc":
+	case a.keys.Is(key, keymap.Copy):
+		a.copyErrorDetails()
+	case action == keymap.Back:
 		a.navigateBackFromError()
 	}
 	return nil
 }
 
+// copyErrorDetails puts a bug-report block on the clipboard. The API key is
+// never included.
+func (a *App) copyErrorDetails() {
+	if a.currentError == nil || a.errorView == nil {
+		return
+	}
+	cfg := a.configMgr.Config
+	report := a.currentError.Details() +
+		fmt.Sprintf("Version:    %s\n", constants.BuildInfo()) +
+		fmt.Sprintf("Platform:   %s/%s\n", runtime.GOOS, runtime.GOARCH) +
+		fmt.Sprintf("Provider:   %s\n", cfg.Provider) +
+		fmt.Sprintf("Model:      %s\n", cfg.Model)
+
+	if err := clipboard.WriteAll(report); err != nil {
+		a.errorView.SetStatus(fmt.Sprintf(constants.NextStepsCopyFailed, err))
+		return
+	}
+	a.errorView.SetStatus(constants.ErrorDetailsCopied)
+}
+
+// showUVInstallError reports that uvx could not be found or downloaded.
+// Unless offline, the error screen offers to download uv again; it always
+// offers the official install command.
+func (a *App) showUVInstallError(err error) {
+	info := *views.ErrUVInstallFailed
+	info.Message = err.Error()
+	info.InstallUV = !a.configMgr.Config.Offline
+	// The installer downloads uv, so it is no use offline either
+	if info.InstallUV {
+		info.InstallCommand = uvresolver.InstallCommand()
+	} else {
+		info.Suggestion = "Offline mode is on, so uv is not downloaded. Install uv from a local package, or copy uv and uvx into ~/.skene/bin."
+	}
+	// Installing retries on success
+	info.Retryable = !info.InstallUV
+	a.showError(&info)
+}
+
+// installUV downloads uv into ~/.skene/bin, checks that uvx runs, and
+// reports back with UVInstallDoneMsg
+func (a *App) installUV() tea.Cmd {
+	view := a.errorView
+	if view == nil || view.IsInstalling() {
+		return nil
+	}
+	view.SetInstalling(true)
+	view.SetStatus(constants.UVInstallStarting)
+	p := a.program
+
+	return func() tea.Msg {
+		lastPercent := int64(-1)
+		_, err := uvresolver.ResolveWithProgress(func(done, total int64) {
+			// One message per percent, or per MB when the size is unknown
+			step := done / (1024 * 1024)
+			if total > 0 {
+				step = done * 100 / total
+			}
+			if p != nil && step != lastPercent {
+				lastPercent = step
+				p.Send(UVInstallProgressMsg{View: view, Done: done, Total: total})
+			}
+		})
+		if err == nil {
+			checker := syscheck.NewChecker()
+			if result := checker.RunAllChecks(); result.UV.Status == syscheck.StatusFailed {
+				err = fmt.Errorf("%s", result.UV.Message)
+			}
+		}
+		return UVInstallDoneMsg{View: view, Err: err}
+	}
+}
+
+func uvInstallProgress(done, total int64) string {
+	if total <= 0 {
+		return fmt.Sprintf(constants.UVInstallProgressMB, float64(done)/(1024*1024))
+	}
+	return fmt.Sprintf(constants.UVInstallProgress, done*100/total)
+}
+
+// copyInstallCommand puts the official uv installer on the clipboard
+func (a *App) copyInstallCommand() {
+	if a.currentError == nil || a.errorView == nil {
+		return
+	}
+	command := a.currentError.InstallCommand
+	if err := clipboard.WriteAll(command); err != nil {
+		a.errorView.SetStatus(fmt.Sprintf(constants.NextStepsCopyFailed, err))
+		return
+	}
+	a.errorView.SetStatus(fmt.Sprintf(constants.UVInstallCopied, command))
+}
+
 func (a *App) handleGameKeys(msg tea.KeyMsg) tea.Cmd {
 	key := msg.String()
-	switch key {
-	case "left", "a":
+	action := a.keys.Action(key, false)
+	switch {
+	case action == keymap.Left || a.keys.Is(key, keymap.MoveLeft):
 		a.game.MoveLeft()
-	case "right", "d":
+	case action == keymap.Right || a.keys.Is(key, keymap.MoveRight):
 		a.game.MoveRight()
-	case " ":
+	case a.keys.Is(key, keymap.Shoot):
 		a.game.Shoot()
-	case "p":
+	case a.keys.Is(key, keymap.Pause):
 		a.game.TogglePause()
-	case "r":
+	case a.keys.Is(key, keymap.Restart):
 		if a.game.IsGameOver() {
 			a.game.Restart()
 		}
-	case "esc":
+	case action == keymap.Back:
 		// Clear progress indicator when exiting game
 		if a.game != nil {
 			a.game.ClearProgressInfo()
 		}
-		a.state = a.prevState
+		a.popState()
 	}
 	return nil
 }
@@ -926,93 +1932,171 @@ func (a *App) selectProvider() tea.Cmd {
 
 	a.selectedProvider = provider
 	a.configMgr.SetProvider(provider.ID)
+	a.authenticated = false
+	a.reauth = false
 
 	// Branch based on provider type
 	if provider.ID == "skene" {
-		// Skene: start callback server and open browser for auth
-		callbackServer, err := auth.NewCallbackServer()
-		if err != nil {
-			a.showError(&views.ErrorInfo{
-				Code:       "AUTH_SERVER_FAILED",
-				Title:      "Authentication Setup Failed",
-				Message:    err.Error(),
-				Suggestion: "Try again or use a different provider.",
-				Severity:   views.SeverityError,
-				Retryable:  true,
-			})
-			return nil
-		}
-
-		if err := callbackServer.Start(); err != nil {
-			a.showError(&views.ErrorInfo{
-				Code:       "AUTH_SERVER_FAILED",
-				Title:      "Authentication Setup Failed",
-				Message:    err.Error(),
-				Suggestion: "Try again or use a different provider.",
-				Severity:   views.SeverityError,
-				Retryable:  true,
-			})
-			return nil
-		}
+		return a.startSkeneAuth(provider)
+	}
 
-		a.callbackServer = callbackServer
+	// Regular providers: go to model selection
+	a.modelView = views.NewModelView(provider)
+	a.modelView.SelectModel(a.configMgr.Config.Model)
+	a.modelView.SetSize(a.width, a.height)
+	a.pushState(StateModelSelect)
+	return nil
+}
 
-		// Build the auth URL with the callback parameter
-		authURL := provider.AuthURL
-		if authURL == "" {
-			authURL = "https://www.skene.ai/login"
+// checkAuthKey checks the format of the key from the sign-in page. A
+// malformed key switches to manual entry at once; a well-formed one is
+// accepted after the verifying screen has been up for the configured delay.
+// A check still pending after constants.AuthVerifyTimeout fails, so the
+// screen can never hang.
+func (a *App) checkAuthKey(callback AuthCallbackMsg) tea.Cmd {
+	id := a.authCheckID
+	if err := auth.CheckKeyFormat(callback.APIKey); err != nil {
+		return func() tea.Msg {
+			return authKeyCheckedMsg{ID: id, Err: err}
 		}
-		authURL = fmt.Sprintf("%s?callback=%s", authURL, callbackServer.GetCallbackURL())
+	}
+	accept := tea.Tick(a.configMgr.AuthVerifyDelay(), func(time.Time) tea.Msg {
+		return authKeyCheckedMsg{ID: id, APIKey: callback.APIKey, Model: callback.Model}
+	})
+	timeout := tea.Tick(constants.AuthVerifyTimeout, func(time.Time) tea.Msg {
+		return authKeyCheckedMsg{ID: id, Err: fmt.Errorf(constants.AuthKeyCheckTimeout, constants.AuthVerifyTimeout)}
+	})
+	return tea.Batch(accept, timeout)
+}
 
-		a.authView = views.NewAuthView(provider)
-		a.authView.SetAuthURL(authURL)
-		a.authView.SetSize(a.width, a.height)
-		a.authCountdown = 3
-		a.state = StateAuth
-		return tea.Batch(countdown(3), a.waitForAuthCallback())
+// startSkeneAuth starts the magic-link sign-in: a local callback server
+// receives the key once the browser flow completes
+func (a *App) startSkeneAuth(provider *config.Provider) tea.Cmd {
+	callbackServer, err := auth.NewCallbackServer()
+	if err != nil {
+		a.showError(&views.ErrorInfo{
+			Code:       "AUTH_SERVER_FAILED",
+			Title:      "Authentication Setup Failed",
+			Message:    err.Error(),
+			Suggestion: "Try again or use a different provider.",
+			Severity:   views.SeverityError,
+			Retryable:  true,
+		})
+		return nil
 	}
 
-	if provider.IsLocal {
-		// Local model: detect runtime
-		a.localModelView = views.NewLocalModelView(provider.ID)
-		a.localModelView.SetSize(a.width, a.height)
-		a.state = StateLocalModel
-		return a.detectLocalModels()
+	if err := callbackServer.Start(); err != nil {
+		a.showError(&views.ErrorInfo{
+			Code:       "AUTH_SERVER_FAILED",
+			Title:      "Authentication Setup Failed",
+			Message:    err.Error(),
+			Suggestion: "Try again or use a different provider.",
+			Severity:   views.SeverityError,
+			Retryable:  true,
+		})
+		return nil
 	}
 
-	// Regular providers: go to model selection
-	a.modelView = views.NewModelView(provider)
-	a.modelView.SetSize(a.width, a.height)
-	a.state = StateModelSelect
-	return nil
+	a.callbackServer = callbackServer
+
+	// Build the auth URL with the callback parameter
+	authURL := provider.AuthURL
+	if authURL == "" {
+		authURL = "https://www.skene.ai/login"
+	}
+	authURL = fmt.Sprintf("%s?callback=%s", authURL, callbackServer.GetCallbackURL())
+
+	a.authView = views.NewAuthView(provider)
+	a.authView.SetAuthURL(authURL)
+	a.authView.SetSize(a.width, a.height)
+	a.pushState(StateAuth)
+	if !auth.CanOpenBrowser() {
+		// Over SSH the callback may still arrive through a forwarded
+		// port, so keep listening while offering manual entry
+		a.authView.SetAuthState(views.AuthStateRemote)
+		return a.waitForAuthCallback()
+	}
+	a.authCountdown = 3
+	return tea.Batch(countdown(3), a.waitForAuthCallback())
 }
 
-func (a *App) selectModel() {
+func (a *App) selectModel() tea.Cmd {
 	model := a.modelView.GetSelectedModel()
 	if model == nil {
-		return
+		return nil
 	}
 
 	a.selectedModel = model
 	a.configMgr.SetModel(model.ID)
 
+	// The key already came from magic-link auth
+	if a.authenticated {
+		return a.transitionToProjectDir()
+	}
+
 	// Go to API key entry
 	a.transitionToAPIKey()
+	return nil
 }
 
 func (a *App) transitionToAPIKey() {
+	// A key typed in by hand continues the wizard as usual
+	a.reauth = false
 	a.apiKeyView = views.NewAPIKeyView(a.selectedProvider, a.selectedModel)
 	a.apiKeyView.SetSize(a.width, a.height)
-	a.state = StateAPIKey
+	a.apiKeyView.SetInsecure(a.configMgr.Config.InsecureSkipVerify)
+	if a.selectedProvider != nil && a.selectedProvider.IsGeneric {
+		a.apiKeyView.SetBaseURL(a.configMgr.Config.GenericBaseURL)
+	}
+	a.pushState(StateAPIKey)
 }
 
-func (a *App) transitionToProjectDir() {
+func (a *App) transitionToProjectDir() tea.Cmd {
+	if a.remote != nil && a.remote.dir == "" {
+		return a.startClone()
+	}
+
 	a.projectDirView = views.NewProjectDirView()
 	a.projectDirView.SetSize(a.width, a.height)
-	a.state = StateProjectDir
+	a.pushState(StateProjectDir)
+
+	if a.presetProjectDir != "" {
+		a.projectDirView.SetProjectDir(a.presetProjectDir)
+		a.useProject(a.presetProjectDir)
+		return a.transitionToAnalysisConfig()
+	}
+	return nil
 }
 
-func (a *App) transitionToAnalysisConfig() {
+// restoreConfiguredSelection selects the provider and model from the loaded
+// config. Returns false if the config is not complete enough to skip ahead.
+func (a *App) restoreConfiguredSelection() bool {
+	cfg := a.configMgr.Config
+	provider := config.GetProviderByID(cfg.Provider)
+	model := config.GetModelByID(cfg.Provider, cfg.Model)
+	if provider == nil || cfg.Model == "" {
+		return false
+	}
+	if cfg.APIKey == "" && !provider.IsLocal {
+		return false
+	}
+	if model == nil {
+		// Custom and locally detected models are not in the static list
+		model = &config.Model{ID: cfg.Model, Name: cfg.Model}
+	}
+	a.selectedProvider = provider
+	a.selectedModel = model
+	return true
+}
+
+func (a *App) transitionToAnalysisConfig() tea.Cmd {
+	if a.autoAccept || a.configMgr.Config.SkipAnalysisConfig {
+		// Without the view, retries keep using the saved settings
+		a.analysisConfigView = nil
+		a.configMgr.Config.Verbose = true
+		return a.startAnalysis()
+	}
+
 	providerName := ""
 	modelName := ""
 	if a.selectedProvider != nil {
@@ -1027,62 +2111,165 @@ func (a *App) transitionToAnalysisConfig() {
 	}
 
 	a.analysisConfigView = views.NewAnalysisConfigView(providerName, modelName, projectDir)
+	a.analysisConfigView.SetGenerateDocs(a.configMgr.Config.GenerateDocs)
 	a.analysisConfigView.SetSize(a.width, a.height)
-	a.state = StateAnalysisConfig
+	a.pushState(StateAnalysisConfig)
+	return countRepoFiles(projectDir, a.configMgr.Config.ExcludeFolders)
+}
+
+// countRepoFiles runs the bounded repository size pre-scan in the background
+func countRepoFiles(projectDir string, excludeFolders []string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), constants.RepoScanTimeout)
+		defer cancel()
+		size := growth.CountRepoFiles(ctx, projectDir, excludeFolders, constants.LargeRepoFileThreshold)
+		return RepoSizeMsg{ProjectDir: projectDir, Size: size}
+	}
 }
 
 func (a *App) transitionToResultsFromExisting() {
-	projectDir := a.configMgr.Config.ProjectDir
-	outputDir := filepath.Join(projectDir, constants.OutputDirName)
+	outputDir := a.outputDir()
 
-	growthPlan := loadFileContent(filepath.Join(outputDir, constants.GrowthPlanFile))
-	manifest := loadFileContent(filepath.Join(outputDir, constants.GrowthManifestFile))
-	growthTemplate := loadFileContent(filepath.Join(outputDir, constants.GrowthTemplateFile))
+	names := a.outputNames()
+	growthPlan := loadFileContent(filepath.Join(outputDir, names.File(constants.ArtifactGrowthPlan)))
+	manifest := loadFileContent(filepath.Join(outputDir, names.File(constants.ArtifactGrowthManifest)))
+	growthTemplate := loadFileContent(filepath.Join(outputDir, names.File(constants.ArtifactGrowthTemplate)))
 
 	a.resultsView = views.NewResultsViewWithContent(growthPlan, manifest, growthTemplate)
+	a.offerDashboard(a.configMgr.Config.Provider)
 	a.resultsView.SetSize(a.width, a.height)
-	a.state = StateResults
+	a.pushState(StateResults)
+}
+
+// offerDashboard links the results to the Skene dashboard when the analysis
+// ran on the Skene provider
+func (a *App) offerDashboard(provider string) {
+	if provider == "skene" {
+		a.resultsView.SetDashboard(a.configMgr.DashboardURL())
+	}
+}
+
+// outputDir is where the analysis writes its files
+func (a *App) outputDir() string {
+	return a.buildEngineConfig().OutputDir
+}
+
+// outputFiles lists the generated files in the project's output directory
+func (a *App) outputFiles() []string {
+	return growth.OutputFiles(a.outputDir(), a.outputNames())
+}
+
+// outputNames returns the configured names of the output files
+func (a *App) outputNames() growth.OutputNames {
+	return growth.OutputNames(a.configMgr.Config.OutputFiles)
 }
 
 func (a *App) refreshResultsView() {
 	if a.resultsView == nil {
 		return
 	}
-	projectDir := a.configMgr.Config.ProjectDir
-	if projectDir == "" {
+	if a.configMgr.Config.ProjectDir == "" {
 		return
 	}
-	outputDir := filepath.Join(projectDir, constants.OutputDirName)
-	a.resultsView.RefreshContent(outputDir)
+	a.resultsView.RefreshContent(a.outputDir(), a.outputNames())
 }
 
 func (a *App) applyAnalysisConfig() {
 	if a.analysisConfigView != nil {
 		a.configMgr.Config.UseGrowth = a.analysisConfigView.GetUseGrowth()
+		a.configMgr.Config.GenerateDocs = a.analysisConfigView.GetGenerateDocs()
 		a.configMgr.Config.Verbose = true
 	}
 }
 
-func (a *App) navigateBackFromAPIKey() {
-	if a.selectedProvider != nil {
-		if a.selectedProvider.ID == "skene" {
-			a.state = StateAuth
-		} else if a.selectedProvider.IsGeneric {
-			a.state = StateProviderSelect
-		} else {
-			a.state = StateModelSelect
+// pushState records the current screen and moves to next. If next is
+// already on the stack, the history is unwound to it instead so looping
+// back through the wizard does not grow the stack.
+func (a *App) pushState(next AppState) {
+	// Build the view before switching to it; an unbuilt screen would
+	// render as a blank placeholder
+	if next == a.state || !a.hasView(next) {
+		return
+	}
+	if !a.unwindTo(next) {
+		a.navStack = append(a.navStack, a.state)
+	}
+	a.state = next
+}
+
+// replaceState moves to next without recording the current screen. Used
+// when a running process finishes so esc never lands on a dead view.
+func (a *App) replaceState(next AppState) {
+	if !a.hasView(next) {
+		return
+	}
+	for len(a.navStack) > 0 && isTransientState(a.navStack[len(a.navStack)-1]) {
+		a.navStack = a.navStack[:len(a.navStack)-1]
+	}
+	a.unwindTo(next)
+	a.state = next
+}
+
+// popState returns to the previous screen, skipping any whose view was
+// never built. Falls back to the welcome screen when the stack is empty.
+func (a *App) popState() {
+	for len(a.navStack) > 0 {
+		prev := a.navStack[len(a.navStack)-1]
+		a.navStack = a.navStack[:len(a.navStack)-1]
+		if a.hasView(prev) {
+			a.state = prev
+			a.updateViewSizes()
+			return
 		}
-	} else {
-		a.state = StateProviderSelect
 	}
+	a.state = StateWelcome
 }
 
-func (a *App) navigateBackFromProjectDir() {
-	if a.selectedProvider != nil && a.selectedProvider.IsLocal {
-		a.state = StateLocalModel
-	} else {
-		a.state = StateAPIKey
+// unwindTo drops target and everything above it from the stack. Returns
+// false if target was not on the stack.
+func (a *App) unwindTo(target AppState) bool {
+	for i, s := range a.navStack {
+		if s == target {
+			a.navStack = a.navStack[:i]
+			return true
+		}
 	}
+	return false
+}
+
+func (a *App) hasView(state AppState) bool {
+	switch state {
+	case StateModelSelect:
+		return a.modelView != nil
+	case StateAuth:
+		return a.authView != nil
+	case StateAPIKey:
+		return a.apiKeyView != nil
+	case StateProjectDir:
+		return a.projectDirView != nil
+	case StateAnalysisConfig:
+		return a.analysisConfigView != nil
+	case StateAnalyzing:
+		return a.analyzingView != nil
+	case StateResults:
+		return a.resultsView != nil
+	case StateNextSteps:
+		return a.nextStepsView != nil
+	case StateError:
+		return a.errorView != nil
+	case StateGame:
+		return a.game != nil
+	case StateCloning:
+		return a.cloningView != nil
+	case StateHistory:
+		return a.historyView != nil
+	}
+	return true
+}
+
+// isTransientState reports whether a screen only exists while a process runs
+func isTransientState(state AppState) bool {
+	return state == StateAnalyzing || state == StateGame
 }
 
 func (a *App) navigateBackFromAnalyzing() {
@@ -1092,72 +2279,77 @@ func (a *App) navigateBackFromAnalyzing() {
 		a.cancelFunc = nil
 	}
 
-	switch a.analyzingOrigin {
-	case StateNextSteps:
+	// Skip past the game as well if it was opened while waiting
+	for isTransientState(a.state) {
+		a.popState()
+	}
+	if a.state == StateNextSteps {
 		a.refreshResultsView()
-		a.state = StateNextSteps
-		if a.nextStepsView == nil {
-			a.nextStepsView = views.NewNextStepsView()
-		}
-		a.nextStepsView.SetSize(a.width, a.height)
-	case StateAnalysisConfig:
-		a.state = StateAnalysisConfig
-		if a.analysisConfigView != nil {
-			a.analysisConfigView.SetSize(a.width, a.height)
-		}
-	default:
-		a.state = StateAnalysisConfig
+		a.nextStepsView.SetOutputFiles(a.outputFiles())
 	}
 }
 
 func (a *App) navigateBackFromError() {
+	a.popState()
 	// If the error came from a running process, skip back to the origin
 	// so the user can re-trigger it rather than landing on a dead view.
-	if a.prevState == StateAnalyzing {
+	if isTransientState(a.state) {
 		a.navigateBackFromAnalyzing()
-		return
-	}
-
-	target := a.prevState
-	switch target {
-	case StateModelSelect:
-		if a.modelView == nil {
-			target = StateProviderSelect
-		}
-	case StateAuth:
-		if a.authView == nil {
-			target = StateProviderSelect
-		}
-	case StateAPIKey:
-		if a.apiKeyView == nil {
-			target = StateProviderSelect
-		}
-	case StateLocalModel:
-		if a.localModelView == nil {
-			target = StateProviderSelect
-		}
-	case StateProjectDir:
-		if a.projectDirView == nil {
-			target = StateProviderSelect
-		}
-	case StateAnalysisConfig:
-		if a.analysisConfigView == nil {
-			target = StateProjectDir
-		}
 	}
-	a.state = target
 }
 
 // ═══════════════════════════════════════════════════════════════════
 // ASYNC OPERATIONS
 // ═══════════════════════════════════════════════════════════════════
 
+// startClone shallow-clones the --project URL on the cloning screen after
+// checking that git is installed
+func (a *App) startClone() tea.Cmd {
+	if a.configMgr.Config.Offline {
+		info := *views.ErrCloneFailed
+		info.Message = "Offline mode is on, so " + a.remote.url + " cannot be cloned."
+		info.Retryable = false
+		a.showError(&info)
+		return nil
+	}
+
+	view := views.NewCloningView(a.remote.url)
+	view.SetSize(a.width, a.height)
+	a.cloningView = view
+	a.pushState(StateCloning)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	a.cancelFunc = cancel
+	p := a.program
+	url := a.remote.url
+
+	return func() tea.Msg {
+		if check := syscheck.CheckGit(ctx); check.Status == syscheck.StatusFailed {
+			return CloneDoneMsg{View: view, Err: fmt.Errorf("%s", check.Message), GitMissing: true}
+		}
+		dir, err := gitclone.Clone(ctx, url, func(progress gitclone.Progress) {
+			if p != nil {
+				p.Send(CloneProgressMsg{View: view, Progress: progress})
+			}
+		})
+		return CloneDoneMsg{View: view, Dir: dir, Err: err}
+	}
+}
+
 func (a *App) startAnalysis() tea.Cmd {
+	if err := growth.CheckOutputWritable(a.buildEngineConfig().OutputDir); err != nil {
+		info := *views.ErrPermissionDenied
+		info.Message = err.Error()
+		a.showError(&info)
+		return nil
+	}
+
 	a.analyzingView = views.NewAnalyzingView()
+	a.analyzingView.SetGameEnabled(a.configMgr.Config.MiniGameEnabled)
 	a.analyzingView.SetSize(a.width, a.height)
+	a.analyzingView.SetPhaseNames(growth.PhaseNames(a.buildEngineConfig()))
 	a.analysisStartTime = time.Now()
-	a.analyzingOrigin = StateAnalysisConfig
-	a.state = StateAnalyzing
+	a.pushState(StateAnalyzing)
 	return a.startRealAnalysisCmd(a.program)
 }
 
@@ -1167,36 +2359,37 @@ func (a *App) startRealAnalysisCmd(p *tea.Program) tea.Cmd {
 	ctx, cancel := context.WithCancel(context.Background())
 	a.cancelFunc = cancel
 
-	return func() tea.Msg {
-		engine := growth.NewEngine(cfg, func(update growth.PhaseUpdate) {
-			if p != nil {
-				p.Send(AnalysisPhaseMsg{Update: update})
-			}
-		})
-		engine.SetPromptHandler(func(prompt growth.InteractivePrompt) {
-			if p != nil {
-				p.Send(PromptMsg{
-					Question: prompt.Question,
-					Options:  prompt.Options,
-					Response: prompt.Response,
-				})
-			}
-		})
+	engine := growth.NewEngine(cfg, func(update growth.PhaseUpdate) {
+		if p != nil {
+			p.Send(AnalysisPhaseMsg{Update: update})
+		}
+	})
+	engine.SetPromptHandler(func(prompt growth.InteractivePrompt) {
+		if p != nil {
+			p.Send(PromptMsg{
+				Question: prompt.Question,
+				Options:  prompt.Options,
+				Response: prompt.Response,
+			})
+		}
+	})
+	a.keepWaiting = engine.KeepWaiting
 
+	return a.inBackground(func() tea.Msg {
 		result := engine.Run(ctx)
 		if result.Error != nil {
 			return AnalysisDoneMsg{Error: result.Error, Result: result}
 		}
 		return AnalysisDoneMsg{Error: nil, Result: result}
-	}
+	})
 }
 
 func (a *App) runEngineCommand(title string, command string) tea.Cmd {
 	a.analyzingView = views.NewCommandView(title)
+	a.analyzingView.SetGameEnabled(a.configMgr.Config.MiniGameEnabled)
 	a.analyzingView.SetSize(a.width, a.height)
 	a.analysisStartTime = time.Now()
-	a.analyzingOrigin = StateNextSteps
-	a.state = StateAnalyzing
+	a.pushState(StateAnalyzing)
 
 	cfg := a.buildEngineConfig()
 
@@ -1204,43 +2397,30 @@ func (a *App) runEngineCommand(title string, command string) tea.Cmd {
 	a.cancelFunc = cancel
 
 	p := a.program
-	return func() tea.Msg {
+	engine := newCommandEngine(cfg, p)
+	a.keepWaiting = engine.KeepWaiting
+	return a.inBackground(func() tea.Msg {
 		if ctx.Err() != nil {
 			return NextStepDoneMsg{Error: ctx.Err()}
 		}
 
-		engine := growth.NewEngine(cfg, func(update growth.PhaseUpdate) {
-			if p != nil {
-				p.Send(NextStepOutputMsg{Line: update.Message})
-			}
-		})
-		engine.SetPromptHandler(func(prompt growth.InteractivePrompt) {
-			if p != nil {
-				p.Send(PromptMsg{
-					Question: prompt.Question,
-					Options:  prompt.Options,
-					Response: prompt.Response,
-				})
-			}
-		})
-
 		var result *growth.AnalysisResult
 		switch command {
 		case "plan":
 			if p != nil {
 				p.Send(NextStepOutputMsg{Line: "Running: uvx skene-growth plan ..."})
 			}
-			result = engine.GeneratePlan()
+			result = engine.GeneratePlan(ctx)
 		case "build":
 			if p != nil {
 				p.Send(NextStepOutputMsg{Line: "Running: uvx skene-growth build ..."})
 			}
-			result = engine.GenerateBuild()
+			result = engine.GenerateBuild(ctx)
 		case "validate":
 			if p != nil {
 				p.Send(NextStepOutputMsg{Line: "Running: uvx skene-growth validate ..."})
 			}
-			result = engine.ValidateManifest()
+			result = engine.ValidateManifest(ctx)
 		default:
 			return NextStepDoneMsg{Error: fmt.Errorf("unknown command: %s", command)}
 		}
@@ -1249,9 +2429,65 @@ func (a *App) runEngineCommand(title string, command string) tea.Cmd {
 			return NextStepDoneMsg{Error: result.Error}
 		}
 		return NextStepDoneMsg{Error: nil}
+	})
+}
+
+// regenerateSection reruns only the step behind the active results tab,
+// which is always the growth plan, and swaps in the new content when it
+// finishes
+func (a *App) regenerateSection() tea.Cmd {
+	if a.resultsView == nil || !a.resultsView.CanRegenerate() {
+		return nil
 	}
+	section := a.resultsView.ActiveSection()
+
+	a.analyzingView = views.NewCommandView(fmt.Sprintf(constants.StepNameRegenerate, a.resultsView.ActiveTabName()))
+	a.analyzingView.SetGameEnabled(a.configMgr.Config.MiniGameEnabled)
+	a.analyzingView.SetSize(a.width, a.height)
+	a.analysisStartTime = time.Now()
+	a.pushState(StateAnalyzing)
+
+	cfg := a.buildEngineConfig()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	a.cancelFunc = cancel
+
+	p := a.program
+	engine := newCommandEngine(cfg, p)
+	a.keepWaiting = engine.KeepWaiting
+	return a.inBackground(func() tea.Msg {
+		if p != nil {
+			p.Send(NextStepOutputMsg{Line: "Regenerating " + section.File(cfg.OutputFiles) + " from the existing manifest with skene-growth plan ..."})
+		}
+
+		result := engine.RegenerateSection(ctx, section)
+		if result.Error != nil {
+			return NextStepDoneMsg{Error: result.Error}
+		}
+
+		return NextStepDoneMsg{Section: section, Content: result.GrowthPlan}
+	})
 }
 
+// newCommandEngine creates an engine that streams output and prompts to the
+// command view
+func newCommandEngine(cfg growth.EngineConfig, p *tea.Program) *growth.Engine {
+	engine := growth.NewEngine(cfg, func(update growth.PhaseUpdate) {
+		if p != nil {
+			p.Send(NextStepOutputMsg{Line: update.Message})
+		}
+	})
+	engine.SetPromptHandler(func(prompt growth.InteractivePrompt) {
+		if p != nil {
+			p.Send(PromptMsg{
+				Question: prompt.Question,
+				Options:  prompt.Options,
+				Response: prompt.Response,
+			})
+		}
+	})
+	return engine
+}
 
 func (a *App) waitForAuthCallback() tea.Cmd {
 	server := a.callbackServer
@@ -1279,51 +2515,302 @@ func (a *App) waitForAuthCallback() tea.Cmd {
 	}
 }
 
-func (a *App) detectLocalModels() tea.Cmd {
-	providerID := ""
-	if a.selectedProvider != nil {
-		providerID = a.selectedProvider.ID
+// checkForUpdates queries the release endpoint in the background. Any
+// failure is swallowed so offline users never see an error.
+func (a *App) checkForUpdates() tea.Cmd {
+	if a.noUpdateCheck || a.configMgr.Config.NoUpdateCheck || a.configMgr.Config.Offline {
+		return nil
 	}
 
+	checker := update.NewChecker(a.configMgr.Config.UpdateCheckURL, constants.Version)
 	return func() tea.Msg {
-		// Simulate detection with some default models
-		time.Sleep(500 * time.Millisecond)
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
 
-		var models []string
-		switch providerID {
-		case "ollama":
-			models = []string{"llama3.3", "mistral", "codellama", "deepseek-r1"}
-		case "lmstudio":
-			models = []string{"Currently loaded model"}
+		release, err := checker.Latest(ctx)
+		if err != nil || release == nil {
+			return nil
 		}
+		return UpdateCheckMsg{Latest: release.TagName}
+	}
+}
+
+// isTextInputState returns true for screens where ctrl+h and ? may be
+// consumed by a focused text field
+func (a *App) isTextInputState() bool {
+	if a.state == StateAnalyzing && a.analyzingView != nil {
+		return a.analyzingView.IsSearching()
+	}
+	return a.state == StateAPIKey || a.state == StateProjectDir
+}
+
+// isAnalysisRunning returns true while an analysis or engine command has
+// not finished, including when the mini game is shown on top of it
+func (a *App) isAnalysisRunning() bool {
+	if a.state != StateAnalyzing && a.state != StateGame {
+		return false
+	}
+	return a.analyzingView != nil && !a.analyzingView.IsDone()
+}
+
+// Cleanup stops background work that would outlive the UI: the auth
+// callback server and any running uvx subprocess. Safe to call twice.
+func (a *App) Cleanup() {
+	if a.cancelFunc != nil {
+		a.cancelFunc()
+		a.cancelFunc = nil
+	}
+	if a.callbackServer != nil {
+		a.callbackServer.Shutdown()
+		a.callbackServer = nil
+	}
+	a.pendingPromptResponse = nil
+	growth.KillRunning()
+}
+
+// quit cancels background work before telling Bubble Tea to exit, so a
+// running analysis stops at once rather than when the program has shut down
+func (a *App) quit() tea.Cmd {
+	a.Cleanup()
+	return tea.Quit
+}
+
+// inBackground wraps an engine command so WaitBackground can wait for it.
+// It is counted only once Bubble Tea runs it, so a command that is dropped
+// unexecuted never holds up the exit.
+func (a *App) inBackground(fn func() tea.Msg) tea.Cmd {
+	return func() tea.Msg {
+		a.background.Add(1)
+		defer a.background.Done()
+		return fn()
+	}
+}
 
-		if len(models) > 0 {
-			return LocalModelDetectMsg{Models: models}
+// WaitBackground waits up to timeout for cancelled engine commands to
+// return, reporting false when some were still running
+func (a *App) WaitBackground(timeout time.Duration) bool {
+	done := make(chan struct{})
+	go func() {
+		a.background.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
+
+// RecordCrash saves a report for a recovered panic to the user config
+// directory. Only the first crash of a session is recorded.
+func (a *App) RecordCrash(cause interface{}, stack []byte) {
+	if a.crashed {
+		return
+	}
+	a.crashed = true
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Skene crash report\n\n")
+	fmt.Fprintf(&b, "Time:     %s\n", time.Now().Format(time.RFC3339))
+	fmt.Fprintf(&b, "Version:  %s\n", constants.BuildInfo())
+	fmt.Fprintf(&b, "Platform: %s/%s, %s\n", runtime.GOOS, runtime.GOARCH, runtime.Version())
+	fmt.Fprintf(&b, "State:    %s\n", a.state)
+	fmt.Fprintf(&b, "Terminal: %dx%d\n\n", a.width, a.height)
+	fmt.Fprintf(&b, "Panic: %v\n\n", cause)
+	if len(stack) > 0 {
+		fmt.Fprintf(&b, "Stack trace:\n%s\n", stack)
+	}
+	if data, err := a.configMgr.MaskedJSON(); err == nil {
+		fmt.Fprintf(&b, "Config (API key masked):\n%s\n", data)
+	}
+	a.crashReport = []byte(b.String())
+	a.crashPath, a.crashErr = writeCrashReport(a.crashReport)
+}
+
+// CrashMessage returns what to print once the UI has exited, or "" when
+// nothing crashed. A report that could not be saved is printed in full.
+func (a *App) CrashMessage() string {
+	if !a.crashed {
+		return ""
+	}
+	if a.crashErr != nil {
+		return fmt.Sprintf(constants.CrashReportFailed, a.crashErr) + "\n\n" + string(a.crashReport)
+	}
+	return fmt.Sprintf(constants.CrashReportSaved, a.crashPath)
+}
+
+// writeCrashReport saves report as ~/.config/skene/crash-<time>.log
+func writeCrashReport(report []byte) (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	dir := filepath.Join(home, constants.UserConfigDir)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, fmt.Sprintf(constants.CrashReportFile, time.Now().Format("20060102-150405")))
+	if err := os.WriteFile(path, report, 0600); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// goHome cancels any background work and returns to the welcome screen
+func (a *App) goHome() tea.Cmd {
+	a.Cleanup()
+	a.showHelp = false
+	a.navStack = nil
+	a.state = StateWelcome
+	a.refreshSavedConfig()
+	a.welcomeView.SetSize(a.width, a.height)
+	return a.welcomeView.ResetAnimation()
+}
+
+// checkProviderHealth probes every provider's endpoint in the background.
+// Results arrive as ProviderHealthMsg and never block navigation.
+func (a *App) checkProviderHealth() tea.Cmd {
+	if a.providerHealthStarted || a.configMgr.Config.Offline {
+		return nil
+	}
+	a.providerHealthStarted = true
+
+	var cmds []tea.Cmd
+	for _, p := range config.GetProviders() {
+		url := p.HealthURL
+		if p.IsLocal || p.IsGeneric {
+			url = p.DefaultBase
+			if a.configMgr.Config.Provider == p.ID && a.configMgr.Config.BaseURL != "" {
+				url = a.configMgr.Config.BaseURL
+			}
 		}
-		return LocalModelDetectMsg{
-			Error: fmt.Errorf("could not connect to local model server"),
+		if url == "" {
+			continue
 		}
+
+		id := p.ID
+		insecure := p.IsGeneric && a.configMgr.Config.InsecureSkipVerify
+		cmds = append(cmds, func() tea.Msg {
+			err := health.Probe(context.Background(), url, insecure)
+			return ProviderHealthMsg{ProviderID: id, Reachable: err == nil}
+		})
 	}
+	return tea.Batch(cmds...)
+}
+
+// isPythonMissing reports whether uv found no interpreter satisfying
+// min_python
+func isPythonMissing(err error) bool {
+	return containsAny(err.Error(), "No interpreter found", "No Python installation found")
+}
+
+// showPythonNotFound explains that uv has no Python at least min_python
+func (a *App) showPythonNotFound(err error) {
+	min := a.configMgr.MinPython().String()
+	info := *views.ErrPythonNotFound
+	info.Message = fmt.Sprintf(constants.ErrorPythonMessage, min) + "\n\n" + err.Error()
+	info.Suggestion = fmt.Sprintf(constants.ErrorPythonFix, min, min)
+	info.Retryable = true
+	a.showError(&info)
+}
+
+// isUnauthorized reports whether the provider rejected the API key
+func isUnauthorized(err error) bool {
+	return containsAny(strings.ToLower(err.Error()), "401", "unauthorized", "invalid api key", "invalid_api_key")
+}
+
+// isUVError returns true if the analysis failed because uvx is missing or
+// could not be downloaded
+func isUVError(err error) bool {
+	return containsAny(err.Error(), uvresolver.ErrNotInstalled.Error(), "failed to locate uvx", "failed to download uv")
 }
 
 // analysisErrorSuggestion returns a contextual suggestion based on the error
 func analysisErrorSuggestion(err error) string {
 	s := err.Error()
-	if containsAny(s, "failed to locate uvx", "failed to download uv") {
-		return "The CLI could not provision the uvx runtime. Check your internet connection and try again."
-	}
 	if containsAny(s, "No module named", "not found: skene-growth", "package not found") {
 		return "The skene-growth package could not be found. Make sure it is published or install it manually."
 	}
 	if containsAny(s, "API key", "401", "unauthorized") {
 		return "Check your API key, ensure it has the required permissions, and try again."
 	}
+	if containsAny(s, "timed out") {
+		return "The provider took too long to respond. Try again, or raise idle_timeout / analysis_timeout in your config."
+	}
 	if containsAny(s, "network", "connection", "timeout") {
 		return "Check your network connection and try again."
 	}
 	return "Check the output above for details and try again."
 }
 
+// isModelNotFound reports whether the provider rejected the requested model,
+// e.g. OpenAI's model_not_found or a 404 naming the model
+func isModelNotFound(err error) bool {
+	s := strings.ToLower(err.Error())
+	if containsAny(s, "model_not_found", "model not found", "unknown model", "invalid model") {
+		return true
+	}
+	return strings.Contains(s, "model") && containsAny(s, "does not exist", "not_found_error", "404")
+}
+
+// showModelNotFound explains a rejected model, offering the closest listed
+// model when the provider has a fixed list
+func (a *App) showModelNotFound(err error) {
+	cfg := a.configMgr.Config
+	providerName := cfg.Provider
+	if p := config.GetProviderByID(cfg.Provider); p != nil {
+		providerName = p.Name
+	}
+	info := &views.ErrorInfo{
+		Code:           constants.ErrorModelNotFound,
+		Title:          constants.ErrorModelTitle,
+		Message:        fmt.Sprintf(constants.ErrorModelMessage, providerName, cfg.Model),
+		Suggestion:     constants.ErrorModelNoList,
+		Severity:       views.SeverityError,
+		Retryable:      true,
+		Reconfigurable: true,
+	}
+	if model := config.ClosestModel(cfg.Provider, cfg.Model); model != nil {
+		info.SuggestedModel = model.ID
+		info.Suggestion = fmt.Sprintf(constants.ErrorModelDidYouMean, cfg.Model, model.ID)
+	}
+	a.showError(info)
+}
+
+// isQuotaExceeded reports whether the provider refused the request for
+// billing reasons, as opposed to a short-lived rate limit
+func isQuotaExceeded(err error) bool {
+	return containsAny(strings.ToLower(err.Error()),
+		"insufficient_quota", "insufficient quota", "exceeded your current quota",
+		"credit balance is too low", "insufficient balance", "insufficient credits",
+		"payment required")
+}
+
+// showQuotaExceeded explains a quota or billing failure, offering the
+// provider's cheapest model when the analysis used a pricier one
+func (a *App) showQuotaExceeded(err error) {
+	cfg := a.configMgr.Config
+	providerName := cfg.Provider
+	if p := config.GetProviderByID(cfg.Provider); p != nil {
+		providerName = p.Name
+	}
+	info := &views.ErrorInfo{
+		Code:           constants.ErrorQuotaExceeded,
+		Title:          constants.ErrorQuotaTitle,
+		Message:        fmt.Sprintf(constants.ErrorQuotaMessage, providerName) + "\n\n" + err.Error(),
+		Suggestion:     constants.ErrorQuotaNoCheaper,
+		Severity:       views.SeverityError,
+		Retryable:      true,
+		Reconfigurable: true,
+	}
+	if model := config.CheaperModel(cfg.Provider, cfg.Model); model != nil {
+		info.SuggestedModel = model.ID
+		info.Suggestion = fmt.Sprintf(constants.ErrorQuotaCheaper, model.ID, providerName)
+	}
+	a.showError(info)
+}
+
 func containsAny(s string, substrs ...string) bool {
 	for _, sub := range substrs {
 		if len(s) >= len(sub) {
@@ -1337,12 +2824,24 @@ func containsAny(s string, substrs ...string) bool {
 	return false
 }
 
+// recordFailure counts consecutive identical analysis failures. Changing the
+// provider, model, key or endpoint starts the count over. Returns the count.
+func (a *App) recordFailure(err error) int {
+	cfg := a.configMgr.Config
+	key := strings.Join([]string{cfg.Provider, cfg.Model, cfg.APIKey, cfg.BaseURL, cfg.ProjectDir, err.Error()}, "\x00")
+	if key != a.failureKey {
+		a.failureKey = key
+		a.failureCount = 0
+	}
+	a.failureCount++
+	return a.failureCount
+}
+
 func (a *App) showError(err *views.ErrorInfo) {
-	a.prevState = a.state
 	a.currentError = err
 	a.errorView = views.NewErrorView(err)
 	a.errorView.SetSize(a.width, a.height)
-	a.state = StateError
+	a.pushState(StateError)
 }
 
 // ═══════════════════════════════════════════════════════════════════
@@ -1365,9 +2864,6 @@ func (a *App) updateViewSizes() {
 	if a.apiKeyView != nil {
 		a.apiKeyView.SetSize(a.width, a.height)
 	}
-	if a.localModelView != nil {
-		a.localModelView.SetSize(a.width, a.height)
-	}
 	if a.projectDirView != nil {
 		a.projectDirView.SetSize(a.width, a.height)
 	}
@@ -1389,14 +2885,41 @@ func (a *App) updateViewSizes() {
 	if a.game != nil {
 		a.game.SetSize(60, 20)
 	}
+	if a.cloningView != nil {
+		a.cloningView.SetSize(a.width, a.height)
+	}
+	if a.historyView != nil {
+		a.historyView.SetSize(a.width, a.height)
+	}
 }
 
 // ═══════════════════════════════════════════════════════════════════
 // VIEW RENDERING
 // ═══════════════════════════════════════════════════════════════════
 
-// View renders the current wizard step
-func (a *App) View() string {
+// View renders the current wizard step. After a crash it renders nothing,
+// and a panic while rendering is recorded like one in Update.
+func (a *App) View() (view string) {
+	if a.crashed {
+		return ""
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			a.RecordCrash(r, debug.Stack())
+			view = ""
+			if a.program != nil {
+				go a.program.Quit()
+			}
+		}
+	}()
+	return a.view()
+}
+
+func (a *App) view() string {
+	if a.isTerminalTooSmall() {
+		return a.renderTerminalTooSmall()
+	}
+
 	var content string
 
 	switch a.state {
@@ -1416,10 +2939,6 @@ func (a *App) View() string {
 		if a.apiKeyView != nil {
 			content = a.apiKeyView.Render()
 		}
-	case StateLocalModel:
-		if a.localModelView != nil {
-			content = a.localModelView.Render()
-		}
 	case StateProjectDir:
 		if a.projectDirView != nil {
 			content = a.projectDirView.Render()
@@ -1444,6 +2963,14 @@ func (a *App) View() string {
 		if a.errorView != nil {
 			content = a.errorView.Render()
 		}
+	case StateCloning:
+		if a.cloningView != nil {
+			content = a.cloningView.Render()
+		}
+	case StateHistory:
+		if a.historyView != nil {
+			content = a.historyView.Render()
+		}
 	case StateGame:
 		if a.game != nil {
 			content = lipgloss.Place(
@@ -1456,20 +2983,39 @@ func (a *App) View() string {
 		}
 	}
 
-	// Safety: if a state rendered nothing (nil view), show a fallback
+	// Safety: if a state rendered nothing (nil view), say which screen is
+	// missing and how to leave it
 	if content == "" {
 		content = lipgloss.Place(
 			a.width,
 			a.height,
 			lipgloss.Center,
 			lipgloss.Center,
-			styles.Muted.Render("Loading..."),
+			lipgloss.JoinVertical(
+				lipgloss.Center,
+				styles.Muted.Render(fmt.Sprintf(constants.ViewInitializing, a.state)),
+				"",
+				components.FooterHelp([]components.HelpItem{
+					{Key: constants.HelpKeyEsc, Desc: constants.HelpDescGoBack},
+				}),
+			),
 		)
 	}
 
+	if a.confirmHome {
+		content = a.renderHomeConfirm()
+	} else if a.confirmRegenerate {
+		content = a.renderRegenerateConfirm()
+	} else if status := a.renderStatusLine(); status != "" {
+		content = withStatusLine(content, status, a.height)
+	}
+
 	// Overlay help if visible
 	if a.showHelp {
 		helpItems := a.getCurrentHelpItems()
+		if !a.isTextInputState() {
+			helpItems = append(helpItems, components.HelpItem{Key: constants.HelpKeyCtrlH, Desc: constants.HelpDescHome})
+		}
 		a.helpOverlay.SetItems(helpItems)
 		overlay := a.helpOverlay.Render(a.width, a.height)
 		if overlay != "" {
@@ -1480,6 +3026,97 @@ func (a *App) View() string {
 	return content
 }
 
+// renderStatusLine shows the provider, model and project chosen so far in
+// this session. Empty until a provider has been picked.
+func (a *App) renderStatusLine() string {
+	if a.selectedProvider == nil {
+		return ""
+	}
+	switch a.state {
+	case StateWelcome, StateProviderSelect, StateGame:
+		return ""
+	}
+
+	parts := []string{a.selectedProvider.Name}
+	if a.selectedModel != nil {
+		parts = append(parts, a.selectedModel.Name)
+	} else if a.state != StateModelSelect && a.configMgr.Config.Model != "" {
+		parts = append(parts, a.configMgr.Config.Model)
+	}
+	switch a.state {
+	case StateAnalysisConfig, StateAnalyzing, StateResults, StateNextSteps, StateError:
+		if dir := a.configMgr.Config.ProjectDir; dir != "" {
+			parts = append(parts, config.GetShortenedPath(dir, constants.StatusLinePathMax))
+		}
+	}
+	line := " " + strings.Join(parts, " · ")
+	return styles.Muted.MaxWidth(a.width).Render(line)
+}
+
+// withStatusLine puts status on the bottom row, making room by dropping the
+// lowest blank line so the view still fits the terminal height
+func withStatusLine(content, status string, height int) string {
+	lines := strings.Split(content, "\n")
+	if len(lines) >= height {
+		for i := len(lines) - 1; i >= 0; i-- {
+			if strings.TrimSpace(lines[i]) == "" {
+				lines = append(lines[:i], lines[i+1:]...)
+				break
+			}
+		}
+	}
+	return strings.Join(append(lines, status), "\n")
+}
+
+func (a *App) renderHomeConfirm() string {
+	box := styles.Box.Width(50).Render(lipgloss.JoinVertical(
+		lipgloss.Left,
+		styles.SectionHeader.Render(constants.HomeConfirmTitle),
+		"",
+		styles.Body.Render(constants.HomeConfirmMessage),
+		"",
+		styles.Muted.Render(constants.HomeConfirmHint),
+	))
+	return lipgloss.Place(a.width, a.height, lipgloss.Center, lipgloss.Center, box)
+}
+
+func (a *App) renderRegenerateConfirm() string {
+	file := growth.SectionPlan.File(a.configMgr.Config.OutputFiles)
+	box := styles.Box.Width(50).Render(lipgloss.JoinVertical(
+		lipgloss.Left,
+		styles.SectionHeader.Render(constants.RegenerateConfirmTitle),
+		"",
+		styles.Body.Width(44).Render(fmt.Sprintf(constants.RegenerateConfirmMessage, file)),
+		"",
+		styles.Muted.Render(constants.RegenerateConfirmHint),
+	))
+	return lipgloss.Place(a.width, a.height, lipgloss.Center, lipgloss.Center, box)
+}
+
+// isTerminalTooSmall returns true once the size is known and below the
+// minimum the view layouts can render without overflowing
+func (a *App) isTerminalTooSmall() bool {
+	if a.width == 0 && a.height == 0 {
+		return false
+	}
+	return a.width < constants.MinTerminalWidth || a.height < constants.MinTerminalHeight
+}
+
+func (a *App) renderTerminalTooSmall() string {
+	msg := lipgloss.JoinVertical(
+		lipgloss.Center,
+		styles.Accent.Render(fmt.Sprintf(constants.TerminalTooSmall, constants.MinTerminalWidth, constants.MinTerminalHeight)),
+		styles.Muted.Render(fmt.Sprintf(constants.TerminalTooSmallSize, a.width, a.height)),
+	)
+	return lipgloss.Place(
+		a.width,
+		a.height,
+		lipgloss.Center,
+		lipgloss.Center,
+		lipgloss.NewStyle().Width(a.width).Align(lipgloss.Center).Render(msg),
+	)
+}
+
 func (a *App) getCurrentHelpItems() []components.HelpItem {
 	switch a.state {
 	case StateWelcome:
@@ -1498,10 +3135,6 @@ func (a *App) getCurrentHelpItems() []components.HelpItem {
 		if a.apiKeyView != nil {
 			return a.apiKeyView.GetHelpItems()
 		}
-	case StateLocalModel:
-		if a.localModelView != nil {
-			return a.localModelView.GetHelpItems()
-		}
 	case StateProjectDir:
 		if a.projectDirView != nil {
 			return a.projectDirView.GetHelpItems()
@@ -1526,6 +3159,14 @@ func (a *App) getCurrentHelpItems() []components.HelpItem {
 		if a.errorView != nil {
 			return a.errorView.GetHelpItems()
 		}
+	case StateCloning:
+		if a.cloningView != nil {
+			return a.cloningView.GetHelpItems()
+		}
+	case StateHistory:
+		if a.historyView != nil {
+			return a.historyView.GetHelpItems()
+		}
 	}
 
 	return components.NewHelpOverlay().Items
@@ -1548,18 +3189,36 @@ func (a *App) buildEngineConfig() growth.EngineConfig {
 	if outputDir == "" {
 		outputDir = "./skene-context"
 	}
-	if !filepath.IsAbs(outputDir) {
+	if a.remote != nil {
+		// The clone is deleted on exit, so results go under the working directory
+		if outputDir == constants.DefaultOutputDir {
+			outputDir = ""
+		}
+		outputDir = gitclone.OutputDir(outputDir, a.remote.url)
+	} else if !filepath.IsAbs(outputDir) {
 		outputDir = filepath.Join(projectDir, outputDir)
 	}
 
 	return growth.EngineConfig{
-		Provider:    a.configMgr.Config.Provider,
-		Model:       a.configMgr.Config.Model,
-		APIKey:      a.configMgr.Config.APIKey,
-		BaseURL:     a.configMgr.Config.BaseURL,
-		ProjectDir:  projectDir,
-		OutputDir:   outputDir,
-		UseGrowth: a.configMgr.Config.UseGrowth,
+		Provider:   a.configMgr.Config.Provider,
+		Model:      a.configMgr.Config.Model,
+		APIKey:     a.configMgr.Config.APIKey,
+		BaseURL:    a.configMgr.Config.BaseURL,
+		ProjectDir: projectDir,
+		OutputDir:  outputDir,
+		UseGrowth:  a.configMgr.Config.UseGrowth,
+
+		BackupPrevious:  a.configMgr.Config.BackupPrevious,
+		IdleTimeout:     a.configMgr.IdleTimeout(),
+		AnalysisTimeout: a.configMgr.AnalysisTimeout(),
+		OutputFiles:     a.outputNames(),
+		MinPython:       a.configMgr.MinPython().String(),
+		IncrementalScan: a.configMgr.Config.IncrementalScan,
+		Offline:         a.configMgr.Config.Offline,
+		Proxy:           a.configMgr.Config.Proxy,
+
+		GenerateDocs:   a.configMgr.Config.GenerateDocs,
+		ExcludeFolders: a.configMgr.Config.ExcludeFolders,
 	}
 }
 
@@ -1577,6 +3236,21 @@ func tick() tea.Cmd {
 	})
 }
 
+// openAuthURL opens the auth page off the UI goroutine; failures come back
+// as AuthBrowserMsg so the URL can be shown for manual use
+func openAuthURL(url string) tea.Cmd {
+	return func() tea.Msg {
+		return AuthBrowserMsg{Error: browser.OpenURL(url)}
+	}
+}
+
+// openDashboardURL opens the Skene dashboard off the UI goroutine
+func openDashboardURL(url string) tea.Cmd {
+	return func() tea.Msg {
+		return DashboardBrowserMsg{Error: browser.OpenURL(url)}
+	}
+}
+
 func countdown(seconds int) tea.Cmd {
 	return tea.Tick(time.Second, func(t time.Time) tea.Msg {
 		return CountdownMsg(seconds)
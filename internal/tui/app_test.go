@@ -0,0 +1,60 @@
+package tui
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"skene/internal/keymap"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestWaitBackgroundAfterCancel(t *testing.T) {
+	a := &App{}
+	ctx, cancel := context.WithCancel(context.Background())
+	started := make(chan struct{})
+	cmd := a.inBackground(func() tea.Msg {
+		close(started)
+		<-ctx.Done()
+		return nil
+	})
+
+	go cmd()
+	<-started
+	if a.WaitBackground(50 * time.Millisecond) {
+		t.Fatal("WaitBackground returned true while the command was running")
+	}
+
+	cancel()
+	if !a.WaitBackground(time.Second) {
+		t.Fatal("WaitBackground returned false after the command was cancelled")
+	}
+}
+
+func TestWaitBackgroundIgnoresUnexecutedCommand(t *testing.T) {
+	a := &App{}
+	_ = a.inBackground(func() tea.Msg { return nil })
+
+	if !a.WaitBackground(time.Second) {
+		t.Fatal("WaitBackground waited for a command that never ran")
+	}
+}
+
+func TestCtrlCInGameCancelsAnalysis(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	a := &App{state: StateGame, keys: keymap.Default(), cancelFunc: cancel}
+
+	_, cmd := a.Update(tea.KeyMsg{Type: tea.KeyCtrlC})
+
+	if ctx.Err() == nil {
+		t.Error("ctrl+c in the game left the analysis running")
+	}
+	if cmd == nil {
+		t.Fatal("ctrl+c in the game returned no command")
+	}
+	if _, ok := cmd().(tea.QuitMsg); !ok {
+		t.Error("ctrl+c in the game did not quit")
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"sort"
 	"strings"
 
+	"skene/internal/constants"
 	"skene/internal/tui/styles"
 
 	"github.com/charmbracelet/lipgloss"
@@ -26,6 +27,11 @@ type DirBrowser struct {
 	scrollOff   int // scroll offset
 	err         error
 	showHidden  bool
+
+	// markProjects flags directories that contain a project marker. Checks
+	// run only for rows being drawn and are cached per path.
+	markProjects bool
+	projectCache map[string]bool
 }
 
 // NewDirBrowser creates a new directory browser starting at the given path
@@ -46,6 +52,35 @@ func (b *DirBrowser) SetHeight(h int) {
 	b.height = h
 }
 
+// SetMarkProjects turns the project indicator on directory rows on or off
+func (b *DirBrowser) SetMarkProjects(on bool) {
+	b.markProjects = on
+	if on && b.projectCache == nil {
+		b.projectCache = make(map[string]bool)
+	}
+}
+
+// isProject returns the cached project check for a directory
+func (b *DirBrowser) isProject(path string) bool {
+	if is, ok := b.projectCache[path]; ok {
+		return is
+	}
+	is := HasProjectMarker(path)
+	b.projectCache[path] = is
+	return is
+}
+
+// HasProjectMarker reports whether dir contains any of
+// constants.ProjectMarkers
+func HasProjectMarker(dir string) bool {
+	for _, marker := range constants.ProjectMarkers {
+		if _, err := os.Stat(filepath.Join(dir, marker)); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
 // Navigate changes the current directory and refreshes the listing
 func (b *DirBrowser) Navigate(path string) {
 	// Expand ~
@@ -177,6 +212,11 @@ func (b *DirBrowser) ToggleHidden() {
 	}
 }
 
+// ShowHidden reports whether hidden files and directories are listed
+func (b *DirBrowser) ShowHidden() bool {
+	return b.showHidden
+}
+
 // CurrentPath returns the current directory path
 func (b *DirBrowser) CurrentPath() string {
 	return b.currentPath
@@ -213,12 +253,19 @@ func (b *DirBrowser) Render(width int) string {
 		Foreground(styles.Cream).
 		Bold(true)
 
+	// Hidden-file state beside the path, so dotfile directories are
+	// known to be missing rather than absent
+	hiddenLabel := constants.DirBrowserHiddenOff
+	if b.showHidden {
+		hiddenLabel = constants.DirBrowserHiddenOn
+	}
+
 	displayPath := b.currentPath
-	maxPathLen := width - 6
+	maxPathLen := width - 8 - len(hiddenLabel)
 	if len(displayPath) > maxPathLen {
 		displayPath = "..." + displayPath[len(displayPath)-maxPathLen+3:]
 	}
-	pathLine := pathStyle.Render(displayPath)
+	pathLine := pathStyle.Render(displayPath) + "  " + styles.Muted.Render(hiddenLabel)
 
 	// Error state
 	if b.err != nil {
@@ -253,16 +300,19 @@ func (b *DirBrowser) Render(width int) string {
 			name = name[:maxNameLen-1] + "~"
 		}
 
+		var line string
 		if i == b.cursor {
-			line := styles.ListItemSelected.Render(name)
-			lines = append(lines, line)
+			line = styles.ListItemSelected.Render(name)
 		} else if entry.IsDir {
-			line := styles.ListItem.Render(name)
-			lines = append(lines, line)
+			line = styles.ListItem.Render(name)
 		} else {
-			line := styles.ListItemDimmed.Render(name)
-			lines = append(lines, line)
+			line = styles.ListItemDimmed.Render(name)
 		}
+		if b.markProjects && entry.IsDir && entry.Name != ".." &&
+			b.isProject(filepath.Join(b.currentPath, entry.Name)) {
+			line += " " + projectDot()
+		}
+		lines = append(lines, line)
 	}
 
 	// Scroll indicators
@@ -280,6 +330,9 @@ func (b *DirBrowser) Render(width int) string {
 
 	// Help line
 	helpLine := styles.Muted.Render("arrows: navigate  enter: open  .: hidden  esc: cancel")
+	if b.markProjects {
+		helpLine += "  " + projectDot() + styles.Muted.Render(" project")
+	}
 
 	parts := []string{pathLine, "", listing}
 	if scrollInfo != "" {
@@ -292,6 +345,11 @@ func (b *DirBrowser) Render(width int) string {
 	return styles.Box.Width(width).Render(content)
 }
 
+// projectDot marks a directory that looks like a project root
+func projectDot() string {
+	return lipgloss.NewStyle().Foreground(styles.Success).Render("●")
+}
+
 func formatScrollPos(start, end, total int) string {
 	return lipgloss.NewStyle().Foreground(styles.MidGray).Render(
 		"[" + itoa(start) + "-" + itoa(end) + " of " + itoa(total) + "]",
@@ -101,13 +101,15 @@ func WizardInputHelp() string {
 	})
 }
 
-// WizardResultsHelp returns help for results screens
-func WizardResultsHelp() string {
-	return FooterHelp([]HelpItem{
+// WizardResultsHelp returns help for results screens, with any extra items
+// listed before quit
+func WizardResultsHelp(extra ...HelpItem) string {
+	items := []HelpItem{
 		{Key: constants.HelpKeyLeftRight, Desc: constants.HelpDescTabs},
 		{Key: constants.HelpKeyUpDown, Desc: constants.HelpDescScroll},
 		{Key: constants.HelpKeyTab, Desc: constants.HelpDescFocus},
 		{Key: constants.HelpKeyN, Desc: constants.HelpDescNextSteps},
-		{Key: constants.HelpKeyCtrlC, Desc: constants.HelpDescQuit},
-	})
+	}
+	items = append(items, extra...)
+	return FooterHelp(append(items, HelpItem{Key: constants.HelpKeyCtrlC, Desc: constants.HelpDescQuit}))
 }
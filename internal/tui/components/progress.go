@@ -1,21 +1,55 @@
 package components
 
 import (
+	"fmt"
+	"strings"
+
+	"skene/internal/constants"
+	"skene/internal/services/config"
 	"skene/internal/tui/styles"
+
+	"github.com/charmbracelet/lipgloss"
 )
 
+// spinnerStyle is the configured style for new spinners; "" picks one
+// from the terminal
+var spinnerStyle string
+
+// SetDefaultSpinnerStyle sets the style new spinners start with. Call it
+// after styles.Init, before creating any views.
+func SetDefaultSpinnerStyle(name string) {
+	spinnerStyle = name
+}
+
 // Spinner component
 type Spinner struct {
 	frames []string
 	index  int
 }
 
-// NewSpinner creates a new spinner
+// NewSpinner creates a new spinner in the configured style
 func NewSpinner() *Spinner {
-	return &Spinner{
-		frames: []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"},
-		index:  0,
+	name := spinnerStyle
+	if _, ok := constants.SpinnerStyles[name]; !ok {
+		name = constants.DefaultSpinnerStyle
+		if styles.IsMono || !styles.HasUnicode {
+			name = constants.ASCIISpinnerStyle
+		}
 	}
+	return &Spinner{frames: constants.SpinnerStyles[name]}
+}
+
+// SetStyle switches to one of constants.SpinnerStyles. An unknown name
+// returns an error and keeps the current frames.
+func (s *Spinner) SetStyle(name string) error {
+	if err := config.CheckSpinnerStyle(name); err != nil {
+		return err
+	}
+	if frames, ok := constants.SpinnerStyles[name]; ok {
+		s.frames = frames
+		s.index = 0
+	}
+	return nil
 }
 
 // Tick advances the spinner
@@ -32,3 +66,38 @@ func (s *Spinner) Render() string {
 func (s *Spinner) SpinnerWithText(text string) string {
 	return s.Render() + " " + styles.Body.Render(text)
 }
+
+// Eighth-block characters for the partially filled cell
+var progressPartials = []string{"", "▏", "▎", "▍", "▌", "▋", "▊", "▉"}
+
+// ProgressBar renders value (0.0–1.0) as a bar followed by a percentage,
+// fitting in width columns. The fill is drawn in eighths of a cell, so the
+// bar moves smoothly at any width.
+func ProgressBar(value float64, width int) string {
+	if value < 0 {
+		value = 0
+	}
+	if value > 1 {
+		value = 1
+	}
+
+	label := fmt.Sprintf(" %3d%%", int(value*100))
+	cells := width - len(label)
+	if cells < 1 {
+		cells = 1
+	}
+
+	eighths := int(value * float64(cells*8))
+	full := eighths / 8
+	partial := progressPartials[eighths%8]
+	empty := cells - full
+	if partial != "" {
+		empty--
+	}
+
+	filled := lipgloss.NewStyle().Foreground(styles.ProgressFilled).
+		Render(strings.Repeat("█", full) + partial)
+	rest := lipgloss.NewStyle().Foreground(styles.ProgressEmpty).
+		Render(strings.Repeat("░", empty))
+	return filled + rest + styles.Muted.Render(label)
+}
@@ -6,6 +6,7 @@ import (
 	"skene/internal/tui/styles"
 	"strings"
 	"sync"
+	"time"
 	"unicode/utf8"
 
 	"github.com/charmbracelet/lipgloss"
@@ -14,6 +15,12 @@ import (
 
 var ansiRe = regexp.MustCompile(`\x1b\[[0-9;]*[a-zA-Z]`)
 
+// timestampLayout prefixes each line when timestamps are shown
+const timestampLayout = "15:04:05.000"
+
+// stampWidth is the width of the prefix, including the space after it
+const stampWidth = len(timestampLayout) + 1
+
 func stripANSI(s string) string {
 	return ansiRe.ReplaceAllString(s, "")
 }
@@ -22,12 +29,26 @@ func stripANSI(s string) string {
 // with word-wrapping and manual scroll support.
 type TerminalOutput struct {
 	lines      []string
+	times      []time.Time // when each line was added
 	maxLines   int
 	width      int
 	height     int
 	scrollOff  int
 	userScroll bool
 	mu         sync.Mutex
+
+	// Width lines were last wrapped at by Render, so scrolling and search
+	// count the same wrapped lines the user sees
+	renderWidth int
+
+	// Prefix each line with the time it was added
+	showTimestamps bool
+
+	// Search state: the term (matched case-insensitively), the wrapped
+	// lines containing it, and which of them is current
+	searchTerm string
+	matches    []int
+	matchIdx   int
 }
 
 // NewTerminalOutput creates a new terminal output display
@@ -55,15 +76,18 @@ func (t *TerminalOutput) AddLine(line string) {
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
+	now := time.Now()
 	newLines := strings.Split(line, "\n")
 	for _, l := range newLines {
 		l = strings.TrimRight(l, "\r")
 		l = stripANSI(l)
 		t.lines = append(t.lines, l)
+		t.times = append(t.times, now)
 	}
 
 	if len(t.lines) > t.maxLines {
 		t.lines = t.lines[len(t.lines)-t.maxLines:]
+		t.times = t.times[len(t.times)-t.maxLines:]
 	}
 
 	if !t.userScroll {
@@ -87,8 +111,12 @@ func (t *TerminalOutput) Clear() {
 	t.mu.Lock()
 	defer t.mu.Unlock()
 	t.lines = make([]string, 0)
+	t.times = nil
 	t.scrollOff = 0
 	t.userScroll = false
+	t.searchTerm = ""
+	t.matches = nil
+	t.matchIdx = 0
 }
 
 // LineCount returns the number of lines
@@ -126,7 +154,111 @@ func (t *TerminalOutput) ScrollDown(n int) {
 	}
 }
 
+// SetShowTimestamps turns the time prefix on each line on or off. Lines
+// keep the time they were added, so turning it on later still shows when
+// each one appeared.
+func (t *TerminalOutput) SetShowTimestamps(show bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.showTimestamps = show
+	if t.searchTerm != "" {
+		t.rematch()
+	}
+}
+
+// ShowTimestamps reports whether lines are prefixed with their time
+func (t *TerminalOutput) ShowTimestamps() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.showTimestamps
+}
+
+// Search highlights term in the output and returns the indices of the
+// wrapped lines containing it, matched case-insensitively. The view scrolls
+// to the last match, nearest the end of the run where errors usually are.
+// An empty term clears the search.
+func (t *TerminalOutput) Search(term string) []int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.searchTerm = term
+	t.matches = nil
+	t.matchIdx = 0
+	if term == "" {
+		return nil
+	}
+	t.findMatches()
+	if len(t.matches) > 0 {
+		t.matchIdx = len(t.matches) - 1
+		t.scrollTo(t.matches[t.matchIdx])
+	}
+	return t.matches
+}
+
+// ClearSearch removes the search highlight
+func (t *TerminalOutput) ClearSearch() {
+	t.Search("")
+}
+
+// NextMatch scrolls to the match below the current one, wrapping around
+func (t *TerminalOutput) NextMatch() {
+	t.stepMatch(1)
+}
+
+// PrevMatch scrolls to the match above the current one, wrapping around
+func (t *TerminalOutput) PrevMatch() {
+	t.stepMatch(-1)
+}
+
+func (t *TerminalOutput) stepMatch(delta int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(t.matches) == 0 {
+		return
+	}
+	t.matchIdx = (t.matchIdx + delta + len(t.matches)) % len(t.matches)
+	t.scrollTo(t.matches[t.matchIdx])
+}
+
+// SearchStatus returns the 1-based position of the current match and the
+// number of matches; current is 0 when nothing matched
+func (t *TerminalOutput) SearchStatus() (current, total int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(t.matches) == 0 {
+		return 0, 0
+	}
+	return t.matchIdx + 1, len(t.matches)
+}
+
+// ScrollToLine scrolls so wrapped line idx is in view, centred where possible
+func (t *TerminalOutput) ScrollToLine(idx int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.scrollTo(idx)
+}
+
+func (t *TerminalOutput) scrollTo(idx int) {
+	total := len(t.wrapAllLines(t.contentWidth()))
+	visible := t.visibleCount()
+	maxOff := total - visible
+	if maxOff < 0 {
+		maxOff = 0
+	}
+	off := total - idx - visible/2 - 1
+	if off < 0 {
+		off = 0
+	}
+	if off > maxOff {
+		off = maxOff
+	}
+	t.scrollOff = off
+	t.userScroll = off > 0
+}
+
 func (t *TerminalOutput) contentWidth() int {
+	if t.renderWidth > 0 {
+		return t.renderWidth
+	}
 	w := t.width - 6
 	if w < 10 {
 		w = 10
@@ -203,9 +335,19 @@ func findBreakPoint(line string, width int) int {
 
 func (t *TerminalOutput) wrapAllLines(contentWidth int) []string {
 	var result []string
-	for _, line := range t.lines {
-		parts := wrapLine(line, contentWidth)
-		result = append(result, parts...)
+	for i, line := range t.lines {
+		if !t.showTimestamps {
+			result = append(result, wrapLine(line, contentWidth)...)
+			continue
+		}
+		// Wrap beside the timestamp, indenting continuation lines under it
+		stamp := t.times[i].Format(timestampLayout) + " "
+		for j, part := range wrapLine(line, contentWidth-stampWidth) {
+			if j > 0 {
+				stamp = strings.Repeat(" ", stampWidth)
+			}
+			result = append(result, stamp+part)
+		}
 	}
 	return result
 }
@@ -224,6 +366,14 @@ func (t *TerminalOutput) Render(width int) string {
 		contentWidth = 10
 	}
 
+	// Re-run the search when the wrap width changed under it
+	if contentWidth != t.renderWidth {
+		t.renderWidth = contentWidth
+		if t.searchTerm != "" {
+			t.rematch()
+		}
+	}
+
 	visibleCount := t.visibleCount()
 	wrapped := t.wrapAllLines(contentWidth)
 
@@ -240,33 +390,55 @@ func (t *TerminalOutput) Render(width int) string {
 	var displayLines []string
 
 	defaultStyle := lipgloss.NewStyle().
-		Foreground(styles.Cream).
-		Width(contentWidth)
+		Foreground(styles.Cream)
 	errorStyle := lipgloss.NewStyle().
-		Foreground(styles.Coral).
-		Width(contentWidth)
+		Foreground(styles.Coral)
 	successStyle := lipgloss.NewStyle().
-		Foreground(styles.Success).
-		Width(contentWidth)
+		Foreground(styles.Success)
 	warningStyle := lipgloss.NewStyle().
-		Foreground(styles.Warning).
-		Width(contentWidth)
+		Foreground(styles.Warning)
+	matchStyle := lipgloss.NewStyle().
+		Foreground(styles.Charcoal).
+		Background(styles.Sand)
+	currentMatchStyle := lipgloss.NewStyle().
+		Foreground(styles.Charcoal).
+		Background(styles.Amber).
+		Bold(true)
+	current := -1
+	if len(t.matches) > 0 {
+		current = t.matches[t.matchIdx]
+	}
+	stampStyle := lipgloss.NewStyle().
+		Foreground(styles.MidGray)
 
 	for i := startIdx; i < endIdx && i < totalWrapped; i++ {
-		line := wrapped[i]
+		stamp, line := t.splitStamp(wrapped[i])
+		lineWidth := contentWidth - len(stamp)
 
 		upper := strings.ToUpper(line)
-		var styled string
+		style := defaultStyle
 		if strings.Contains(upper, "ERROR") || strings.Contains(upper, "FAILED") ||
 			strings.Contains(upper, "TRACEBACK") || strings.Contains(upper, "EXCEPTION") {
-			styled = errorStyle.Render(line)
+			style = errorStyle
 		} else if strings.Contains(line, "✓") || strings.Contains(upper, "SUCCESS") ||
 			strings.Contains(upper, "COMPLETE") || strings.Contains(upper, "DONE") {
-			styled = successStyle.Render(line)
+			style = successStyle
 		} else if strings.Contains(upper, "WARNING") || strings.Contains(upper, "WARN") {
-			styled = warningStyle.Render(line)
+			style = warningStyle
+		}
+
+		var styled string
+		if t.searchTerm != "" && containsFold(line, t.searchTerm) {
+			hl := matchStyle
+			if i == current {
+				hl = currentMatchStyle
+			}
+			styled = lipgloss.NewStyle().Width(lineWidth).Render(highlight(line, t.searchTerm, style, hl))
 		} else {
-			styled = defaultStyle.Render(line)
+			styled = style.Width(lineWidth).Render(line)
+		}
+		if stamp != "" {
+			styled = stampStyle.Render(stamp) + styled
 		}
 		displayLines = append(displayLines, styled)
 	}
@@ -297,3 +469,65 @@ func (t *TerminalOutput) Render(width int) string {
 
 	return result
 }
+
+// rematch recomputes the match indices after the wrap width changed,
+// keeping the current match where it still exists
+func (t *TerminalOutput) rematch() {
+	idx := t.matchIdx
+	t.findMatches()
+	if idx >= len(t.matches) {
+		idx = len(t.matches) - 1
+	}
+	t.matchIdx = max(idx, 0)
+}
+
+// findMatches lists the wrapped lines whose text, not counting the
+// timestamp, contains the search term
+func (t *TerminalOutput) findMatches() {
+	t.matches = nil
+	for i, line := range t.wrapAllLines(t.contentWidth()) {
+		if _, text := t.splitStamp(line); containsFold(text, t.searchTerm) {
+			t.matches = append(t.matches, i)
+		}
+	}
+}
+
+// splitStamp separates the timestamp prefix from a wrapped line
+func (t *TerminalOutput) splitStamp(line string) (stamp, text string) {
+	if !t.showTimestamps || len(line) < stampWidth {
+		return "", line
+	}
+	return line[:stampWidth], line[stampWidth:]
+}
+
+// containsFold reports whether s contains substr, ignoring case
+func containsFold(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
+
+// highlight renders line in base with every occurrence of term in match
+func highlight(line, term string, base, match lipgloss.Style) string {
+	lower, needle := strings.ToLower(line), strings.ToLower(term)
+	if len(lower) != len(line) || len(needle) != len(term) {
+		// Lowercasing changed byte lengths, so offsets would not line up;
+		// fall back to a case-sensitive match
+		lower, needle = line, term
+	}
+
+	var b strings.Builder
+	for {
+		i := strings.Index(lower, needle)
+		if i < 0 {
+			break
+		}
+		if i > 0 {
+			b.WriteString(base.Render(line[:i]))
+		}
+		b.WriteString(match.Render(line[i : i+len(needle)]))
+		line, lower = line[i+len(needle):], lower[i+len(needle):]
+	}
+	if line != "" {
+		b.WriteString(base.Render(line))
+	}
+	return b.String()
+}
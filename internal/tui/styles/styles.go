@@ -2,6 +2,7 @@ package styles
 
 import (
 	"os"
+	"strings"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/muesli/termenv"
@@ -11,11 +12,21 @@ import (
 // Set by Init(); defaults to true if Init() is not called.
 var IsDarkBackground = true
 
+// IsMono indicates the terminal shows no color, because of NO_COLOR or a
+// terminal without color support. Set by Init().
+var IsMono = false
+
+// HasUnicode indicates the locale can display Unicode symbols. Set by
+// Init(); false when LC_ALL, LC_CTYPE or LANG names a non-UTF-8 charset.
+var HasUnicode = true
+
 // Init detects the terminal background color and applies the appropriate
 // color theme. Call this once at startup, before creating any views.
 func Init() {
 	output := termenv.NewOutput(os.Stdout)
 	IsDarkBackground = output.HasDarkBackground()
+	IsMono = output.Profile == termenv.Ascii
+	HasUnicode = localeIsUTF8()
 
 	if !IsDarkBackground {
 		applyLightColors()
@@ -23,6 +34,21 @@ func Init() {
 	rebuildStyles()
 }
 
+// localeIsUTF8 checks the first locale variable that is set. With none set
+// the charset is unknown and assumed to be UTF-8, as on Windows and most
+// macOS terminals.
+func localeIsUTF8() bool {
+	for _, name := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		value := os.Getenv(name)
+		if value == "" {
+			continue
+		}
+		value = strings.ToLower(value)
+		return strings.Contains(value, "utf-8") || strings.Contains(value, "utf8")
+	}
+	return true
+}
+
 // ═══════════════════════════════════════════════════════════════════
 // COLOR PALETTE
 // ═══════════════════════════════════════════════════════════════════
@@ -1,6 +1,8 @@
 package views
 
 import (
+	"fmt"
+
 	"skene/internal/constants"
 	"skene/internal/tui/components"
 	"skene/internal/tui/styles"
@@ -16,6 +18,13 @@ type AnalysisConfigView struct {
 	providerName string
 	modelName    string
 	projectDir   string
+
+	// Product docs, toggled with space
+	generateDocs bool
+
+	// Result of the repository size pre-scan
+	repoFiles     int
+	repoTruncated bool
 }
 
 // NewAnalysisConfigView creates a new analysis configuration view
@@ -25,9 +34,43 @@ func NewAnalysisConfigView(provider, model, projectDir string) *AnalysisConfigVi
 		modelName:    model,
 		projectDir:   projectDir,
 		header:       components.NewWizardHeader(3, constants.StepNameAnalysisConfig),
+
+		generateDocs: true,
 	}
 }
 
+// ProjectDir returns the directory shown in the summary
+func (v *AnalysisConfigView) ProjectDir() string {
+	return v.projectDir
+}
+
+// SetRepoSize records the pre-scan file count. truncated means counting
+// stopped early, so files is a lower bound.
+func (v *AnalysisConfigView) SetRepoSize(files int, truncated bool) {
+	v.repoFiles = files
+	v.repoTruncated = truncated
+}
+
+// IsLargeRepo reports whether the scan should be narrowed before running
+func (v *AnalysisConfigView) IsLargeRepo() bool {
+	return v.repoTruncated || v.repoFiles >= constants.LargeRepoFileThreshold
+}
+
+// SetGenerateDocs sets the initial state of the product docs toggle
+func (v *AnalysisConfigView) SetGenerateDocs(docs bool) {
+	v.generateDocs = docs
+}
+
+// ToggleDocs flips the product docs toggle
+func (v *AnalysisConfigView) ToggleDocs() {
+	v.generateDocs = !v.generateDocs
+}
+
+// GetGenerateDocs returns whether product docs are generated
+func (v *AnalysisConfigView) GetGenerateDocs() bool {
+	return v.generateDocs
+}
+
 // SetSize updates dimensions
 func (v *AnalysisConfigView) SetSize(width, height int) {
 	v.width = width
@@ -53,6 +96,8 @@ func (v *AnalysisConfigView) Render() string {
 	wizHeader := lipgloss.NewStyle().Width(sectionWidth).Render(v.header.Render())
 
 	summarySection := v.renderSummary(sectionWidth)
+	optionsSection := v.renderOptions(sectionWidth)
+	warning := v.renderLargeRepoWarning(sectionWidth)
 
 	button := lipgloss.NewStyle().
 		Width(sectionWidth).
@@ -63,6 +108,7 @@ func (v *AnalysisConfigView) Render() string {
 		Width(v.width).
 		Align(lipgloss.Center).
 		Render(components.FooterHelp([]components.HelpItem{
+			{Key: constants.HelpKeySpace, Desc: constants.HelpDescToggleOption},
 			{Key: constants.HelpKeyEnter, Desc: constants.HelpDescStartAnalysis},
 			{Key: constants.HelpKeyEsc, Desc: constants.HelpDescGoBack},
 			{Key: constants.HelpKeyCtrlC, Desc: constants.HelpDescQuit},
@@ -74,6 +120,9 @@ func (v *AnalysisConfigView) Render() string {
 		"",
 		summarySection,
 		"",
+		warning,
+		optionsSection,
+		"",
 		button,
 	)
 
@@ -114,9 +163,43 @@ func (v *AnalysisConfigView) renderSummary(width int) string {
 	return styles.Box.Width(width).Render(content)
 }
 
+func (v *AnalysisConfigView) renderLargeRepoWarning(width int) string {
+	if !v.IsLargeRepo() {
+		return ""
+	}
+
+	count := fmt.Sprintf("%d", v.repoFiles)
+	if v.repoTruncated {
+		count += "+"
+	}
+	title := lipgloss.NewStyle().Foreground(styles.Warning).Bold(true).Render("⚠ " + fmt.Sprintf(constants.AnalysisConfigLargeRepo, count))
+	tip := lipgloss.NewStyle().Foreground(styles.MidGray).Width(width - 4).Render(constants.AnalysisConfigLargeRepoTip)
+
+	return lipgloss.JoinVertical(lipgloss.Left, title, tip) + "\n"
+}
+
+func (v *AnalysisConfigView) renderOptions(width int) string {
+	header := styles.SectionHeader.Render(constants.AnalysisConfigPhases)
+
+	box := "[ ] "
+	if v.generateDocs {
+		box = "[x] "
+	}
+
+	content := lipgloss.JoinVertical(
+		lipgloss.Left,
+		header,
+		"",
+		styles.ListItemSelected.Render(box+constants.AnalysisConfigDocs),
+	)
+
+	return styles.Box.Width(width).Render(content)
+}
+
 // GetHelpItems returns context-specific help
 func (v *AnalysisConfigView) GetHelpItems() []components.HelpItem {
 	return []components.HelpItem{
+		{Key: constants.HelpKeySpace, Desc: constants.HelpDescToggleOption},
 		{Key: constants.HelpKeyEnter, Desc: constants.HelpDescStartAnalysis},
 		{Key: constants.HelpKeyEsc, Desc: constants.HelpDescGoBack},
 		{Key: constants.HelpKeyCtrlC, Desc: constants.HelpDescQuit},
@@ -1,10 +1,15 @@
 package views
 
 import (
+	"fmt"
+	"time"
+
 	"skene/internal/constants"
 	"skene/internal/tui/components"
 	"skene/internal/tui/styles"
 
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
 
@@ -15,6 +20,9 @@ type AnalysisPhase struct {
 	Done     bool
 	Active   bool
 	Error    string
+
+	StartedAt  time.Time
+	FinishedAt time.Time
 }
 
 // AnalyzingView shows analysis progress with live terminal output
@@ -29,40 +37,72 @@ type AnalyzingView struct {
 	done        bool
 	failMessage string
 	currentIdx  int
+	phaseNames  []string
 
 	promptActive      bool
 	promptQuestion    string
 	promptOptions     []string
 	promptSelectedIdx int
+
+	// When the command last printed anything or a prompt was answered
+	lastOutput time.Time
+
+	// Hides the mini-game offer (mini_game_enabled: false)
+	gameDisabled bool
+
+	// Search over the output once the run has ended. searching is true while
+	// the term is being typed; the highlight stays until it is cleared.
+	searchInput textinput.Model
+	searching   bool
+
+	// Set on short terminals, where the header and progress bar are hidden
+	compact bool
 }
 
 // NewAnalyzingView creates a new analysis progress view
 func NewAnalyzingView() *AnalyzingView {
 	return &AnalyzingView{
-		phases:   []AnalysisPhase{},
-		header:   components.NewTitleHeader(constants.StepNameAnalyzing),
-		spinner:  components.NewSpinner(),
-		terminal: components.NewTerminalOutput(14, 300),
+		phases:      []AnalysisPhase{},
+		header:      components.NewTitleHeader(constants.StepNameAnalyzing),
+		spinner:     components.NewSpinner(),
+		terminal:    components.NewTerminalOutput(14, 300),
+		lastOutput:  time.Now(),
+		searchInput: newSearchInput(),
 	}
 }
 
 // NewCommandView creates a view for running a generic command with terminal output
 func NewCommandView(title string) *AnalyzingView {
 	return &AnalyzingView{
-		phases:   []AnalysisPhase{},
-		header:   components.NewTitleHeader(title),
-		spinner:  components.NewSpinner(),
-		terminal: components.NewTerminalOutput(14, 300),
+		phases:      []AnalysisPhase{},
+		header:      components.NewTitleHeader(title),
+		spinner:     components.NewSpinner(),
+		terminal:    components.NewTerminalOutput(14, 300),
+		lastOutput:  time.Now(),
+		searchInput: newSearchInput(),
 	}
 }
 
+func newSearchInput() textinput.Model {
+	ti := textinput.New()
+	ti.Prompt = constants.HelpKeySlash
+	ti.Placeholder = constants.AnalyzingSearchPlaceholder
+	ti.CharLimit = 100
+	return ti
+}
+
 // SetSize updates dimensions
 func (v *AnalyzingView) SetSize(width, height int) {
 	v.width = width
 	v.height = height
 	v.header.SetWidth(width)
-	// Adjust terminal visible lines based on available height
+	// Adjust terminal visible lines based on available height. Short
+	// terminals keep only the status line above the output.
+	v.compact = height < constants.AnalyzingCompactHeight
 	termHeight := height - 18
+	if v.compact {
+		termHeight = height - 8
+	}
 	if termHeight < 6 {
 		termHeight = 6
 	}
@@ -72,6 +112,17 @@ func (v *AnalyzingView) SetSize(width, height int) {
 	v.terminal.SetSize(width, termHeight)
 }
 
+// SetPhaseNames sets the full ordered phase list used for "Phase N/M"
+// numbering and the ETA estimate
+func (v *AnalyzingView) SetPhaseNames(names []string) {
+	v.phaseNames = names
+}
+
+// SetGameEnabled shows or hides the mini-game offer in the footer
+func (v *AnalyzingView) SetGameEnabled(enabled bool) {
+	v.gameDisabled = !enabled
+}
+
 // TickSpinner advances spinner animation
 func (v *AnalyzingView) TickSpinner() {
 	v.spinner.Tick()
@@ -80,6 +131,7 @@ func (v *AnalyzingView) TickSpinner() {
 // UpdatePhase updates a phase's progress and logs the message to terminal
 // Legacy method kept for backward compatibility with NextStepOutputMsg
 func (v *AnalyzingView) UpdatePhase(index int, progress float64, message string) {
+	v.lastOutput = time.Now()
 	// For generic output messages (index == -1), just log to terminal
 	if index == -1 {
 		if message != "" {
@@ -109,6 +161,7 @@ func (v *AnalyzingView) UpdatePhase(index int, progress float64, message string)
 
 // UpdatePhaseByName updates or creates a phase by name
 func (v *AnalyzingView) UpdatePhaseByName(phaseName string, progress float64, message string) {
+	v.lastOutput = time.Now()
 	// Find existing phase or create new one
 	var phase *AnalysisPhase
 	var phaseIdx int
@@ -122,13 +175,21 @@ func (v *AnalyzingView) UpdatePhaseByName(phaseName string, progress float64, me
 		}
 	}
 	
+	now := time.Now()
 	if !found {
+		// Entering a new phase finishes the previous one
+		for i := range v.phases {
+			if v.phases[i].FinishedAt.IsZero() {
+				v.phases[i].FinishedAt = now
+			}
+		}
 		// Create new phase
 		v.phases = append(v.phases, AnalysisPhase{
-			Name:     phaseName,
-			Progress: progress,
-			Active:   progress < 1.0,
-			Done:     progress >= 1.0,
+			Name:      phaseName,
+			Progress:  progress,
+			Active:    progress < 1.0,
+			Done:      progress >= 1.0,
+			StartedAt: now,
 		})
 		phaseIdx = len(v.phases) - 1
 		phase = &v.phases[phaseIdx]
@@ -210,8 +271,10 @@ func (v *AnalyzingView) ShowPrompt(question string, options []string) {
 	v.promptSelectedIdx = 0
 }
 
-// DismissPrompt hides the interactive prompt
+// DismissPrompt hides the interactive prompt. The command is silent while
+// a prompt waits, so the quiet period starts over.
 func (v *AnalyzingView) DismissPrompt() {
+	v.lastOutput = time.Now()
 	v.promptActive = false
 	v.promptQuestion = ""
 	v.promptOptions = nil
@@ -237,11 +300,26 @@ func (v *AnalyzingView) HandlePromptDown() {
 	}
 }
 
+// SelectPromptOption selects the option with the given 1-based number,
+// reporting false when there is no such option
+func (v *AnalyzingView) SelectPromptOption(n int) bool {
+	if n < 1 || n > len(v.promptOptions) {
+		return false
+	}
+	v.promptSelectedIdx = n - 1
+	return true
+}
+
 // GetSelectedOptionIndex returns the 1-based index of the selected prompt option
 func (v *AnalyzingView) GetSelectedOptionIndex() int {
 	return v.promptSelectedIdx + 1
 }
 
+// QuietFor returns how long the command has printed nothing
+func (v *AnalyzingView) QuietFor() time.Duration {
+	return time.Since(v.lastOutput)
+}
+
 // ScrollUp scrolls the terminal output up
 func (v *AnalyzingView) ScrollUp(n int) {
 	v.terminal.ScrollUp(n)
@@ -252,6 +330,65 @@ func (v *AnalyzingView) ScrollDown(n int) {
 	v.terminal.ScrollDown(n)
 }
 
+// ToggleTimestamps shows or hides the time each output line appeared
+func (v *AnalyzingView) ToggleTimestamps() {
+	v.terminal.SetShowTimestamps(!v.terminal.ShowTimestamps())
+}
+
+// CanSearch reports whether the output can be searched, which is once the
+// run has finished or failed and the buffer no longer moves
+func (v *AnalyzingView) CanSearch() bool {
+	return v.done || v.failed
+}
+
+// StartSearch opens the search input with an empty term
+func (v *AnalyzingView) StartSearch() {
+	v.searching = true
+	v.searchInput.SetValue("")
+	v.searchInput.Focus()
+	v.terminal.ClearSearch()
+}
+
+// IsSearching reports whether the search term is being typed
+func (v *AnalyzingView) IsSearching() bool {
+	return v.searching
+}
+
+// HasSearch reports whether a search term is highlighted in the output
+func (v *AnalyzingView) HasSearch() bool {
+	return v.searchInput.Value() != ""
+}
+
+// UpdateSearch passes a key to the search input and searches as the term
+// is typed
+func (v *AnalyzingView) UpdateSearch(msg tea.Msg) {
+	v.searchInput, _ = v.searchInput.Update(msg)
+	v.terminal.Search(v.searchInput.Value())
+}
+
+// ConfirmSearch closes the search input, keeping the matches highlighted
+func (v *AnalyzingView) ConfirmSearch() {
+	v.searching = false
+	v.searchInput.Blur()
+}
+
+// ClearSearch closes the search input and removes the highlight
+func (v *AnalyzingView) ClearSearch() {
+	v.ConfirmSearch()
+	v.searchInput.SetValue("")
+	v.terminal.ClearSearch()
+}
+
+// NextMatch scrolls to the next search match
+func (v *AnalyzingView) NextMatch() {
+	v.terminal.NextMatch()
+}
+
+// PrevMatch scrolls to the previous search match
+func (v *AnalyzingView) PrevMatch() {
+	v.terminal.PrevMatch()
+}
+
 // GetCurrentPhase returns the current active phase name, or empty string if none
 func (v *AnalyzingView) GetCurrentPhase() string {
 	for _, p := range v.phases {
@@ -279,7 +416,7 @@ func (v *AnalyzingView) Render() string {
 	var statusLine string
 	if v.failed {
 		statusLine = styles.Error.Render("✗ " + constants.AnalyzingFailed)
-		if v.failMessage != "" {
+		if v.failMessage != "" && !v.compact {
 			statusLine += "\n" + lipgloss.NewStyle().
 				Foreground(styles.MidGray).
 				Width(sectionWidth).
@@ -299,13 +436,27 @@ func (v *AnalyzingView) Render() string {
 		}
 		if currentPhase != "" {
 			statusLine = v.spinner.Render() + " " + styles.Body.Render(currentPhase)
+			if timing := v.renderPhaseTiming(); timing != "" {
+				statusLine += "  " + styles.Muted.Render(timing)
+			}
 		} else {
 			statusLine = v.spinner.Render() + " " + styles.Body.Render(constants.AnalyzingRunning)
 		}
+		if quiet := v.QuietFor(); quiet >= constants.ProviderWaitNotice && !v.promptActive {
+			statusLine += "  " + styles.Muted.Render(fmt.Sprintf(constants.AnalyzingWaiting, formatClock(quiet)))
+		}
+	}
+
+	// Overall progress, only when the phases are known up front
+	if progress := v.OverallProgress(); progress >= 0 && !v.failed && !v.compact {
+		statusLine += "\n\n" + components.ProgressBar(progress, sectionWidth)
 	}
 
 	// Terminal output
 	termOutput := v.terminal.Render(sectionWidth)
+	if search := v.renderSearch(); search != "" {
+		termOutput += "\n" + search
+	}
 
 	// Prompt overlay (if active)
 	var promptSection string
@@ -314,31 +465,10 @@ func (v *AnalyzingView) Render() string {
 	}
 
 	// Footer
-	var footerContent string
-	if v.promptActive {
-		footerContent = components.FooterHelp([]components.HelpItem{
-			{Key: constants.HelpKeyUpDown, Desc: constants.HelpDescNavigate},
-			{Key: constants.HelpKeyEnter, Desc: constants.HelpDescSelect},
-			{Key: constants.HelpKeyCtrlC, Desc: constants.HelpDescQuit},
-		})
-	} else if v.done || v.failed {
-		footerContent = components.FooterHelp([]components.HelpItem{
-			{Key: constants.HelpKeyUpDown, Desc: constants.HelpDescScroll},
-			{Key: constants.HelpKeyEsc, Desc: constants.HelpDescGoBack},
-			{Key: constants.HelpKeyCtrlC, Desc: constants.HelpDescQuit},
-		})
-	} else {
-		footerContent = components.FooterHelp([]components.HelpItem{
-			{Key: constants.HelpKeyUpDown, Desc: constants.HelpDescScroll},
-			{Key: constants.HelpKeyEsc, Desc: constants.HelpDescCancel},
-			{Key: constants.HelpKeyG, Desc: constants.HelpDescPlayMiniGame},
-			{Key: constants.HelpKeyCtrlC, Desc: constants.HelpDescQuit},
-		})
-	}
 	footer := lipgloss.NewStyle().
 		Width(v.width).
 		Align(lipgloss.Center).
-		Render(footerContent)
+		Render(components.FooterHelp(v.GetHelpItems()))
 
 	// Combine
 	contentParts := []string{
@@ -348,6 +478,11 @@ func (v *AnalyzingView) Render() string {
 		"",
 		termOutput,
 	}
+	paddingTop := 2
+	if v.compact {
+		contentParts = contentParts[2:]
+		paddingTop = 0
+	}
 	if promptSection != "" {
 		contentParts = append(contentParts, "", promptSection)
 	}
@@ -356,7 +491,7 @@ func (v *AnalyzingView) Render() string {
 		contentParts...,
 	)
 
-	padded := lipgloss.NewStyle().PaddingTop(2).Render(content)
+	padded := lipgloss.NewStyle().PaddingTop(paddingTop).Render(content)
 
 	centered := lipgloss.Place(
 		v.width,
@@ -369,6 +504,81 @@ func (v *AnalyzingView) Render() string {
 	return centered + "\n" + footer
 }
 
+// OverallProgress returns the share of the analysis completed, from 0.0 to
+// 1.0, weighting each expected phase equally. Returns -1 for single
+// commands, whose phases are not known in advance.
+func (v *AnalyzingView) OverallProgress() float64 {
+	if len(v.phaseNames) == 0 {
+		return -1
+	}
+	if v.done {
+		return 1.0
+	}
+
+	var sum float64
+	for _, p := range v.phases {
+		for _, name := range v.phaseNames {
+			if name != p.Name {
+				continue
+			}
+			if p.Done {
+				sum += 1.0
+			} else if p.Progress > 0 {
+				sum += p.Progress
+			}
+		}
+	}
+	return sum / float64(len(v.phaseNames))
+}
+
+// renderPhaseTiming returns "Phase 3/6 · 0:42 · ETA ~2:10" for the active
+// phase. The ETA assumes remaining phases take as long as finished ones did.
+func (v *AnalyzingView) renderPhaseTiming() string {
+	if v.currentIdx < 0 || v.currentIdx >= len(v.phases) {
+		return ""
+	}
+	current := v.phases[v.currentIdx]
+	if current.StartedAt.IsZero() {
+		return ""
+	}
+
+	number, total := v.currentIdx+1, len(v.phases)
+	for i, name := range v.phaseNames {
+		if name == current.Name {
+			number, total = i+1, len(v.phaseNames)
+			break
+		}
+	}
+
+	elapsed := time.Since(current.StartedAt)
+	timing := fmt.Sprintf(constants.AnalyzingPhaseTime, number, total, formatClock(elapsed))
+
+	var finished time.Duration
+	count := 0
+	for _, p := range v.phases {
+		if !p.FinishedAt.IsZero() {
+			finished += p.FinishedAt.Sub(p.StartedAt)
+			count++
+		}
+	}
+	if count > 0 && total > number {
+		avg := finished / time.Duration(count)
+		remaining := avg * time.Duration(total-number)
+		if elapsed < avg {
+			remaining += avg - elapsed
+		}
+		timing += " · " + fmt.Sprintf(constants.AnalyzingETA, formatClock(remaining))
+	}
+
+	return timing
+}
+
+// formatClock formats a duration as m:ss
+func formatClock(d time.Duration) string {
+	secs := int(d.Round(time.Second).Seconds())
+	return fmt.Sprintf("%d:%02d", secs/60, secs%60)
+}
+
 func (v *AnalyzingView) renderPrompt(width int) string {
 	question := styles.Accent.Render(v.promptQuestion)
 
@@ -395,26 +605,71 @@ func (v *AnalyzingView) renderPrompt(width int) string {
 		Render(inner)
 }
 
+// renderSearch shows the search input while typing, then the term and
+// where the current match is
+func (v *AnalyzingView) renderSearch() string {
+	if v.searching {
+		return "  " + v.searchInput.View() + "  " + v.searchCount()
+	}
+	if v.HasSearch() {
+		return "  " + styles.Accent.Render(constants.HelpKeySlash+v.searchInput.Value()) + "  " + v.searchCount()
+	}
+	return ""
+}
+
+func (v *AnalyzingView) searchCount() string {
+	if v.searchInput.Value() == "" {
+		return ""
+	}
+	current, total := v.terminal.SearchStatus()
+	if total == 0 {
+		return styles.Muted.Render(constants.AnalyzingSearchNone)
+	}
+	return styles.Muted.Render(fmt.Sprintf(constants.AnalyzingSearchCount, current, total))
+}
+
 // GetHelpItems returns context-specific help
 func (v *AnalyzingView) GetHelpItems() []components.HelpItem {
 	if v.promptActive {
 		return []components.HelpItem{
 			{Key: constants.HelpKeyUpDown, Desc: constants.HelpDescNavigate},
 			{Key: constants.HelpKeyEnter, Desc: constants.HelpDescSelect},
+			{Key: constants.HelpKeyNumbers, Desc: constants.HelpDescPickOption},
+			{Key: constants.HelpKeyEsc, Desc: constants.HelpDescCancel},
+			{Key: constants.HelpKeyCtrlC, Desc: constants.HelpDescQuit},
+		}
+	}
+	if v.searching {
+		return []components.HelpItem{
+			{Key: constants.HelpKeyEnter, Desc: constants.HelpDescSearch},
+			{Key: constants.HelpKeyEsc, Desc: constants.HelpDescCancel},
+			{Key: constants.HelpKeyCtrlC, Desc: constants.HelpDescQuit},
+		}
+	}
+	if v.HasSearch() {
+		return []components.HelpItem{
+			{Key: constants.HelpKeyNextPrev, Desc: constants.HelpDescNextMatch},
+			{Key: constants.HelpKeySlash, Desc: constants.HelpDescSearch},
+			{Key: constants.HelpKeyEsc, Desc: constants.HelpDescClearSearch},
 			{Key: constants.HelpKeyCtrlC, Desc: constants.HelpDescQuit},
 		}
 	}
 	if v.done || v.failed {
 		return []components.HelpItem{
 			{Key: constants.HelpKeyUpDown, Desc: constants.HelpDescScroll},
+			{Key: constants.HelpKeySlash, Desc: constants.HelpDescSearch},
+			{Key: constants.HelpKeyT, Desc: constants.HelpDescTimestamps},
 			{Key: constants.HelpKeyEsc, Desc: constants.HelpDescGoBack},
 			{Key: constants.HelpKeyCtrlC, Desc: constants.HelpDescQuit},
 		}
 	}
-	return []components.HelpItem{
+	items := []components.HelpItem{
 		{Key: constants.HelpKeyUpDown, Desc: constants.HelpDescScroll},
+		{Key: constants.HelpKeyT, Desc: constants.HelpDescTimestamps},
 		{Key: constants.HelpKeyEsc, Desc: constants.HelpDescCancel},
-		{Key: constants.HelpKeyG, Desc: constants.HelpDescPlayMiniGame},
-		{Key: constants.HelpKeyCtrlC, Desc: constants.HelpDescQuit},
 	}
+	if !v.gameDisabled {
+		items = append(items, components.HelpItem{Key: constants.HelpKeyG, Desc: constants.HelpDescPlayMiniGame})
+	}
+	return append(items, components.HelpItem{Key: constants.HelpKeyCtrlC, Desc: constants.HelpDescQuit})
 }
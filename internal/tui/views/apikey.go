@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
 
@@ -27,13 +28,22 @@ type APIKeyView struct {
 	retryCount   int
 	baseURLInput textinput.Model // For generic providers
 	showBaseURL  bool
+
+	// Base URL preset from constants.GenericEndpoints; -1 is custom entry,
+	// whose value is kept in customURL while a preset is shown
+	preset    int
+	customURL string
+
+	// TLS skip-verify toggle, also generic providers only
+	insecure        bool
+	insecureFocused bool
 }
 
 // NewAPIKeyView creates a new API key view
 func NewAPIKeyView(provider *config.Provider, model *config.Model) *APIKeyView {
 	ti := textinput.New()
 	ti.Placeholder = "Enter API Key"
-	ti.CharLimit = 256
+	ti.CharLimit = 1024 // some gateway tokens are long JWTs
 	ti.Width = 45
 	ti.EchoMode = textinput.EchoPassword
 	ti.EchoCharacter = '•'
@@ -54,7 +64,42 @@ func NewAPIKeyView(provider *config.Provider, model *config.Model) *APIKeyView {
 		spinner:      components.NewSpinner(),
 		baseURLInput: urlInput,
 		showBaseURL:  showBaseURL,
+		preset:       -1,
+	}
+}
+
+// SetBaseURL prefills the base URL, selecting the matching preset if any
+func (v *APIKeyView) SetBaseURL(url string) {
+	v.baseURLInput.SetValue(url)
+	v.preset = -1
+	for i, endpoint := range constants.GenericEndpoints {
+		if endpoint.URL == url {
+			v.preset = i
+			return
+		}
+	}
+	v.customURL = url
+}
+
+// IsBaseURLFocused reports whether the base URL field has focus
+func (v *APIKeyView) IsBaseURLFocused() bool {
+	return v.baseURLInput.Focused()
+}
+
+// CyclePreset moves through the endpoint presets and custom entry,
+// prefilling the base URL. Leaving custom entry remembers what was typed.
+func (v *APIKeyView) CyclePreset(delta int) {
+	if v.preset == -1 {
+		v.customURL = v.baseURLInput.Value()
 	}
+	n := len(constants.GenericEndpoints) + 1
+	v.preset = (v.preset+1+delta+n)%n - 1
+	if v.preset == -1 {
+		v.baseURLInput.SetValue(v.customURL)
+	} else {
+		v.baseURLInput.SetValue(constants.GenericEndpoints[v.preset].URL)
+	}
+	v.baseURLInput.CursorEnd()
 }
 
 // SetProvider updates provider and model
@@ -64,6 +109,14 @@ func (v *APIKeyView) SetProvider(provider *config.Provider, model *config.Model)
 	v.showBaseURL = provider != nil && provider.IsGeneric
 }
 
+// ProviderID returns the ID of the provider the key is entered for
+func (v *APIKeyView) ProviderID() string {
+	if v.provider == nil {
+		return ""
+	}
+	return v.provider.ID
+}
+
 // SetSize updates dimensions
 func (v *APIKeyView) SetSize(width, height int) {
 	v.width = width
@@ -71,33 +124,89 @@ func (v *APIKeyView) SetSize(width, height int) {
 	v.header.SetWidth(width)
 }
 
-// Update handles text input updates, routing to whichever input is focused
-func (v *APIKeyView) Update(msg interface{}) {
+// Update handles text input updates, routing to whichever input is focused.
+// The returned command carries a ctrl+v clipboard read back to Update.
+func (v *APIKeyView) Update(msg tea.Msg) tea.Cmd {
+	if v.insecureFocused {
+		return nil
+	}
+	var cmd tea.Cmd
 	if v.baseURLInput.Focused() {
-		v.baseURLInput, _ = v.baseURLInput.Update(msg)
+		v.baseURLInput, cmd = v.baseURLInput.Update(msg)
+		trimInput(&v.baseURLInput)
 	} else {
-		v.textInput, _ = v.textInput.Update(msg)
+		v.textInput, cmd = v.textInput.Update(msg)
+		trimInput(&v.textInput)
 	}
+	return cmd
 }
 
-// HandleTab toggles between API key and Base URL inputs
+// trimInput drops whitespace around a pasted value. textinput turns a
+// trailing newline from the clipboard into a space, which would otherwise
+// end up in the key.
+func trimInput(ti *textinput.Model) {
+	value := ti.Value()
+	if trimmed := strings.TrimSpace(value); trimmed != value {
+		ti.SetValue(trimmed)
+	}
+}
+
+// HandleTab cycles API key -> Base URL -> TLS toggle
 func (v *APIKeyView) HandleTab() {
 	if !v.showBaseURL {
 		return // Nothing to cycle to
 	}
-	// Toggle between API key and Base URL
-	if v.textInput.Focused() {
+	switch {
+	case v.textInput.Focused():
 		v.textInput.Blur()
 		v.baseURLInput.Focus()
-	} else {
+	case v.baseURLInput.Focused():
 		v.baseURLInput.Blur()
+		v.insecureFocused = true
+	default:
+		v.insecureFocused = false
 		v.textInput.Focus()
 	}
 }
 
-// GetAPIKey returns the entered API key
+// ToggleReveal switches the API key between masked and plain text
+func (v *APIKeyView) ToggleReveal() {
+	if v.textInput.EchoMode == textinput.EchoPassword {
+		v.textInput.EchoMode = textinput.EchoNormal
+	} else {
+		v.textInput.EchoMode = textinput.EchoPassword
+	}
+}
+
+// IsInsecureFocused reports whether the TLS toggle has focus
+func (v *APIKeyView) IsInsecureFocused() bool {
+	return v.insecureFocused
+}
+
+// ToggleInsecure flips the TLS skip-verify option
+func (v *APIKeyView) ToggleInsecure() {
+	v.insecure = !v.insecure
+}
+
+// SetInsecure pre-fills the TLS skip-verify option from config
+func (v *APIKeyView) SetInsecure(insecure bool) {
+	v.insecure = insecure
+}
+
+// GetInsecure returns whether TLS verification should be skipped. Always
+// false for providers without the option.
+func (v *APIKeyView) GetInsecure() bool {
+	return v.showBaseURL && v.insecure
+}
+
+// GetAPIKey returns the entered API key. A value starting with "@" names a
+// file to read the key from; "" is returned if that file cannot be read.
 func (v *APIKeyView) GetAPIKey() string {
-	return v.textInput.Value()
+	key, err := config.ResolveAPIKey(v.textInput.Value())
+	if err != nil {
+		return ""
+	}
+	return key
 }
 
 // GetBaseURL returns the entered base URL
@@ -136,7 +245,11 @@ func (v *APIKeyView) TickSpinner() {
 
 // Validate checks if the API key is valid (basic validation)
 func (v *APIKeyView) Validate() bool {
-	key := v.textInput.Value()
+	key, err := config.ResolveAPIKey(v.textInput.Value())
+	if err != nil {
+		v.error = err.Error()
+		return false
+	}
 
 	// Provider-specific validation
 	if v.provider != nil {
@@ -196,7 +309,7 @@ func (v *APIKeyView) Render() string {
 	footer := lipgloss.NewStyle().
 		Width(v.width).
 		Align(lipgloss.Center).
-		Render(components.WizardInputHelp())
+		Render(components.FooterHelp(v.GetHelpItems()))
 
 	// Combine
 	content := lipgloss.JoinVertical(
@@ -258,6 +371,11 @@ func (v *APIKeyView) renderContent(width int) string {
 	// API Key input
 	apiKeyLabel := styles.Label.Render("API Key:")
 	inputField := v.textInput.View()
+	if value := v.textInput.Value(); strings.HasPrefix(value, "@") {
+		inputField += "\n" + styles.Muted.Render(fmt.Sprintf(constants.APIKeyFromFile, value[1:]))
+	} else if n := len([]rune(value)); n > 0 {
+		inputField += "\n" + styles.Muted.Render(fmt.Sprintf(constants.APIKeyLength, n))
+	}
 
 	var elements []string
 	elements = append(elements, header, "")
@@ -276,6 +394,22 @@ func (v *APIKeyView) renderContent(width int) string {
 		elements = append(elements, "")
 		elements = append(elements, styles.Label.Render("Base URL:"))
 		elements = append(elements, v.baseURLInput.View())
+		elements = append(elements, v.renderPresets())
+
+		box := "[ ] "
+		if v.insecure {
+			box = "[x] "
+		}
+		elements = append(elements, "")
+		if v.insecureFocused {
+			elements = append(elements, styles.ListItemSelected.Render(box+constants.APIKeyInsecureLabel))
+		} else {
+			elements = append(elements, styles.ListItem.Render(box+constants.APIKeyInsecureLabel))
+		}
+		if v.insecure {
+			elements = append(elements, lipgloss.NewStyle().
+				Foreground(styles.Warning).Bold(true).Width(width-8).Render("⚠ "+constants.APIKeyInsecureWarning))
+		}
 	}
 
 	// Validating state
@@ -304,18 +438,50 @@ func (v *APIKeyView) renderContent(width int) string {
 	return styles.Box.Width(width).Render(content)
 }
 
+// renderPresets lists the endpoint presets with the current one highlighted
+func (v *APIKeyView) renderPresets() string {
+	parts := []string{styles.Muted.Render(constants.APIKeyPresets)}
+	names := []string{constants.APIKeyPresetCustom}
+	for _, endpoint := range constants.GenericEndpoints {
+		names = append(names, endpoint.Name)
+	}
+	for i, name := range names {
+		if i-1 == v.preset {
+			parts = append(parts, styles.Accent.Render(name))
+		} else {
+			parts = append(parts, styles.Muted.Render(name))
+		}
+		if i < len(names)-1 {
+			parts = append(parts, styles.Muted.Render(" · "))
+		}
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Top, parts...)
+}
+
 // GetHelpItems returns context-specific help
 func (v *APIKeyView) GetHelpItems() []components.HelpItem {
+	if v.baseURLInput.Focused() {
+		return []components.HelpItem{
+			{Key: constants.HelpKeyEnter, Desc: constants.HelpDescSubmit},
+			{Key: constants.HelpKeyUpDown, Desc: constants.HelpDescEndpointPreset},
+			{Key: constants.HelpKeyTab, Desc: constants.HelpDescSwitchField},
+			{Key: constants.HelpKeyEsc, Desc: constants.HelpDescGoBack},
+			{Key: constants.HelpKeyCtrlC, Desc: constants.HelpDescQuit},
+		}
+	}
 	if v.showBaseURL {
 		return []components.HelpItem{
 			{Key: constants.HelpKeyEnter, Desc: constants.HelpDescSubmit},
 			{Key: constants.HelpKeyTab, Desc: constants.HelpDescSwitchField},
+			{Key: constants.HelpKeySpace, Desc: constants.HelpDescToggleOption},
+			{Key: constants.HelpKeyCtrlR, Desc: constants.HelpDescRevealKey},
 			{Key: constants.HelpKeyEsc, Desc: constants.HelpDescGoBack},
 			{Key: constants.HelpKeyCtrlC, Desc: constants.HelpDescQuit},
 		}
 	}
 	return []components.HelpItem{
 		{Key: constants.HelpKeyEnter, Desc: constants.HelpDescSubmit},
+		{Key: constants.HelpKeyCtrlR, Desc: constants.HelpDescRevealKey},
 		{Key: constants.HelpKeyEsc, Desc: constants.HelpDescGoBack},
 		{Key: constants.HelpKeyCtrlC, Desc: constants.HelpDescQuit},
 	}
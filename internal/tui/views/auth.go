@@ -2,7 +2,6 @@ package views
 
 import (
 	"fmt"
-	"net/url"
 	"skene/internal/constants"
 	"skene/internal/services/config"
 	"skene/internal/tui/components"
@@ -22,6 +21,10 @@ type AuthView struct {
 	header       *components.WizardHeader
 	spinner      *components.Spinner
 	authState    AuthState
+
+	browserErr string // why the browser could not be opened
+	status     string // result of the last copy
+	verifyErr  string // why the received key was not accepted
 }
 
 // AuthState represents the authentication state
@@ -34,6 +37,7 @@ const (
 	AuthStateVerifying
 	AuthStateSuccess
 	AuthStateFallback
+	AuthStateRemote // no browser here; show the URL and offer manual entry
 )
 
 // NewAuthView creates a new auth view
@@ -81,26 +85,35 @@ func (v *AuthView) SetAuthURL(u string) {
 	v.authURL = u
 }
 
-// getDisplayURL returns a clean URL for display (without query params)
-func (v *AuthView) getDisplayURL() string {
-	parsed, err := url.Parse(v.authURL)
-	if err != nil {
-		return v.authURL
-	}
-	return fmt.Sprintf("%s://%s%s", parsed.Scheme, parsed.Host, parsed.Path)
-}
-
 // SetAuthState updates the auth state
 func (v *AuthView) SetAuthState(state AuthState) {
 	v.authState = state
 }
 
+// SetBrowserError records that the auth URL could not be opened, so it is
+// shown for opening by hand
+func (v *AuthView) SetBrowserError(err error) {
+	v.browserErr = err.Error()
+}
+
+// SetStatus shows a one-line message such as the copy result
+func (v *AuthView) SetStatus(status string) {
+	v.status = status
+}
+
 // ShowFallback enables fallback mode
 func (v *AuthView) ShowFallback() {
 	v.showFallback = true
 	v.authState = AuthStateFallback
 }
 
+// ShowKeyRejected switches to manual entry because the key received from
+// the sign-in page cannot be used
+func (v *AuthView) ShowKeyRejected(err error) {
+	v.verifyErr = fmt.Sprintf(constants.AuthKeyRejected, err)
+	v.ShowFallback()
+}
+
 // IsFallbackShown returns if fallback is shown
 func (v *AuthView) IsFallbackShown() bool {
 	return v.showFallback
@@ -139,6 +152,8 @@ func (v *AuthView) Render() string {
 		authContent = v.renderVerifying(sectionWidth)
 	case AuthStateSuccess:
 		authContent = v.renderSuccess(sectionWidth)
+	case AuthStateRemote:
+		authContent = v.renderRemote(sectionWidth)
 	default:
 		authContent = v.renderCountdown(sectionWidth)
 	}
@@ -147,10 +162,7 @@ func (v *AuthView) Render() string {
 	footer := lipgloss.NewStyle().
 		Width(v.width).
 		Align(lipgloss.Center).
-		Render(components.FooterHelp([]components.HelpItem{
-			{Key: constants.HelpKeyM, Desc: constants.HelpDescManualEntry},
-			{Key: constants.HelpKeyEsc, Desc: constants.HelpDescCancel},
-		}))
+		Render(components.FooterHelp(v.GetHelpItems()))
 
 	// Combine
 	fullContent := lipgloss.JoinVertical(
@@ -174,9 +186,20 @@ func (v *AuthView) Render() string {
 	return centered + "\n" + footer
 }
 
+// renderURL shows the full auth URL, including the callback parameter, so
+// it can be copied or selected
+func (v *AuthView) renderURL(width int) string {
+	url := lipgloss.NewStyle().Foreground(styles.Amber).Width(width - 8).Render(v.authURL)
+	hint := v.status
+	if hint == "" {
+		hint = constants.AuthCopyHint
+	}
+	return lipgloss.JoinVertical(lipgloss.Center, url, "", styles.Muted.Render(hint))
+}
+
 func (v *AuthView) renderCountdown(width int) string {
 	message := styles.Body.Render(constants.AuthOpeningBrowser)
-	url := lipgloss.NewStyle().Foreground(styles.Amber).Width(width-8).Render(v.getDisplayURL())
+	url := v.renderURL(width)
 
 	countdownText := fmt.Sprintf(constants.AuthRedirectingIn, v.countdown)
 	countdownStyled := styles.Muted.Render(countdownText)
@@ -214,7 +237,13 @@ func (v *AuthView) renderCountdown(width int) string {
 func (v *AuthView) renderWaiting(width int) string {
 	message := v.spinner.SpinnerWithText(constants.AuthWaiting)
 	subMessage := styles.Muted.Render(constants.AuthWaitingSub)
-	url := lipgloss.NewStyle().Foreground(styles.Amber).Width(width-8).Render(v.getDisplayURL())
+	if v.browserErr != "" {
+		failed := lipgloss.NewStyle().Foreground(styles.Coral).Width(width - 8).
+			Render(fmt.Sprintf(constants.AuthBrowserFailed, v.browserErr))
+		manual := lipgloss.NewStyle().Foreground(styles.White).Bold(true).Render(constants.AuthOpenManually)
+		subMessage = lipgloss.JoinVertical(lipgloss.Center, failed, "", manual)
+	}
+	url := v.renderURL(width)
 
 	content := lipgloss.JoinVertical(
 		lipgloss.Center,
@@ -231,6 +260,36 @@ func (v *AuthView) renderWaiting(width int) string {
 		Render(content)
 }
 
+func (v *AuthView) renderRemote(width int) string {
+	message := lipgloss.NewStyle().Foreground(styles.White).Bold(true).Width(width - 8).
+		Align(lipgloss.Center).Render(constants.AuthRemoteMessage)
+	subMessage := lipgloss.NewStyle().Foreground(styles.MidGray).Width(width - 8).
+		Align(lipgloss.Center).Render(constants.AuthRemoteSub)
+	waiting := v.spinner.SpinnerWithText(constants.AuthWaiting)
+
+	content := lipgloss.JoinVertical(
+		lipgloss.Center,
+		message,
+		"",
+		subMessage,
+		"",
+		v.renderURL(width),
+		"",
+		waiting,
+	)
+
+	return styles.Box.
+		Width(width).
+		Align(lipgloss.Center).
+		Render(content)
+}
+
+// IsRemote returns true when the view offers manual entry because no
+// browser can be opened
+func (v *AuthView) IsRemote() bool {
+	return v.authState == AuthStateRemote
+}
+
 func (v *AuthView) renderVerifying(width int) string {
 	message := v.spinner.SpinnerWithText(constants.AuthVerifying)
 	subMessage := styles.Muted.Render(constants.AuthVerifyingSub)
@@ -276,7 +335,11 @@ func (v *AuthView) renderFallback() string {
 
 	wizHeader := lipgloss.NewStyle().Width(sectionWidth).Render(v.header.Render())
 
-	message := lipgloss.NewStyle().Foreground(styles.White).Width(sectionWidth-8).Render(constants.AuthFallbackMessage)
+	reason := constants.AuthFallbackMessage
+	if v.verifyErr != "" {
+		reason = v.verifyErr
+	}
+	message := lipgloss.NewStyle().Foreground(styles.White).Width(sectionWidth-8).Render(reason)
 	subMessage := lipgloss.NewStyle().Foreground(styles.MidGray).Width(sectionWidth-8).Render(constants.AuthFallbackSub)
 	hint := lipgloss.NewStyle().Foreground(styles.Amber).Width(sectionWidth-8).Render(constants.AuthFallbackHint)
 
@@ -331,7 +394,15 @@ func (v *AuthView) GetHelpItems() []components.HelpItem {
 			{Key: constants.HelpKeyEsc, Desc: constants.HelpDescBackToProvider},
 		}
 	}
+	if v.authState == AuthStateRemote {
+		return []components.HelpItem{
+			{Key: constants.HelpKeyC, Desc: constants.HelpDescCopyURL},
+			{Key: constants.HelpKeyEnter, Desc: constants.HelpDescManualEntry},
+			{Key: constants.HelpKeyEsc, Desc: constants.HelpDescCancelGoBack},
+		}
+	}
 	return []components.HelpItem{
+		{Key: constants.HelpKeyC, Desc: constants.HelpDescCopyURL},
 		{Key: constants.HelpKeyM, Desc: constants.HelpDescSkipManualEntry},
 		{Key: constants.HelpKeyEsc, Desc: constants.HelpDescCancelGoBack},
 	}
@@ -0,0 +1,101 @@
+package views
+
+import (
+	"skene/internal/constants"
+	"skene/internal/tui/components"
+	"skene/internal/tui/styles"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// CloningView shows the progress of cloning a repository given by URL
+type CloningView struct {
+	width    int
+	height   int
+	url      string
+	stage    string
+	progress float64
+	header   *components.WizardHeader
+	spinner  *components.Spinner
+}
+
+// NewCloningView creates a cloning view for url
+func NewCloningView(url string) *CloningView {
+	return &CloningView{
+		url:     url,
+		stage:   constants.CloneConnecting,
+		header:  components.NewTitleHeader(constants.StepNameCloning),
+		spinner: components.NewSpinner(),
+	}
+}
+
+// SetSize updates dimensions
+func (v *CloningView) SetSize(width, height int) {
+	v.width = width
+	v.height = height
+	v.header.SetWidth(width)
+}
+
+// SetProgress updates the current git stage and overall progress (0.0–1.0)
+func (v *CloningView) SetProgress(stage string, progress float64) {
+	v.stage = stage
+	v.progress = progress
+}
+
+// TickSpinner advances the spinner
+func (v *CloningView) TickSpinner() {
+	v.spinner.Tick()
+}
+
+// Render the cloning view
+func (v *CloningView) Render() string {
+	sectionWidth := v.width - 20
+	if sectionWidth < 60 {
+		sectionWidth = 60
+	}
+	if sectionWidth > 80 {
+		sectionWidth = 80
+	}
+
+	wizHeader := lipgloss.NewStyle().Width(sectionWidth).Render(v.header.Render())
+
+	// Box padding takes 4 columns
+	inner := sectionWidth - 4
+	content := lipgloss.JoinVertical(
+		lipgloss.Left,
+		styles.Accent.MaxWidth(inner).Render(v.url),
+		"",
+		v.spinner.SpinnerWithText(v.stage),
+		"",
+		components.ProgressBar(v.progress, inner),
+		"",
+		styles.Muted.Width(inner).Render(constants.CloneHint),
+	)
+	box := styles.Box.Width(sectionWidth).Render(content)
+
+	footer := lipgloss.NewStyle().
+		Width(v.width).
+		Align(lipgloss.Center).
+		Render(components.FooterHelp(v.GetHelpItems()))
+
+	fullContent := lipgloss.JoinVertical(lipgloss.Left, wizHeader, "", "", box)
+	padded := lipgloss.NewStyle().PaddingTop(2).Render(fullContent)
+
+	centered := lipgloss.Place(
+		v.width,
+		v.height-3,
+		lipgloss.Center,
+		lipgloss.Top,
+		padded,
+	)
+
+	return centered + "\n" + footer
+}
+
+// GetHelpItems returns context-specific help
+func (v *CloningView) GetHelpItems() []components.HelpItem {
+	return []components.HelpItem{
+		{Key: constants.HelpKeyEsc, Desc: constants.HelpDescCancel},
+		{Key: constants.HelpKeyCtrlC, Desc: constants.HelpDescQuit},
+	}
+}
@@ -1,6 +1,9 @@
 package views
 
 import (
+	"fmt"
+	"strings"
+
 	"skene/internal/constants"
 	"skene/internal/tui/components"
 	"skene/internal/tui/styles"
@@ -25,6 +28,16 @@ type ErrorInfo struct {
 	Suggestion string
 	Severity   ErrorSeverity
 	Retryable  bool
+	// Reconfigurable adds a button that jumps back to provider selection
+	Reconfigurable bool
+	// SuggestedModel adds a button that switches to this model and retries
+	SuggestedModel string
+	// InstallUV adds a button that downloads uv and retries
+	InstallUV bool
+	// InstallCommand adds a button that copies this command to the clipboard
+	InstallCommand string
+	// Reauthenticate adds a button that signs in to Skene again and retries
+	Reauthenticate bool
 }
 
 // ErrorView displays errors with suggested fixes and retry
@@ -34,15 +47,40 @@ type ErrorView struct {
 	error       *ErrorInfo
 	buttonGroup *components.ButtonGroup
 	header      *components.WizardHeader
+	status      string
+	installing  bool
 }
 
 // NewErrorView creates a new error view
 func NewErrorView(err *ErrorInfo) *ErrorView {
-	var buttons *components.ButtonGroup
+	var labels []string
+	if err.Reauthenticate {
+		labels = append(labels, constants.ButtonReauth)
+	}
+	if err.InstallUV {
+		labels = append(labels, constants.ButtonInstallUV)
+	}
+	if err.InstallCommand != "" {
+		labels = append(labels, constants.ButtonInstallCmd)
+	}
+	if err.SuggestedModel != "" {
+		labels = append(labels, UseModelLabel(err.SuggestedModel))
+	}
 	if err.Retryable {
-		buttons = components.NewButtonGroup("Retry", "Go Back", "Quit")
-	} else {
-		buttons = components.NewButtonGroup("Go Back", "Quit")
+		labels = append(labels, constants.ButtonRetry)
+	}
+	if err.Reconfigurable {
+		labels = append(labels, constants.ButtonReconfig)
+	}
+	labels = append(labels, constants.ButtonCopyError, constants.ButtonGoBack, constants.ButtonQuit)
+	buttons := components.NewButtonGroup(labels...)
+	if err.Reconfigurable && err.SuggestedModel == "" && !err.InstallUV {
+		// Make the suggested way out the default
+		for i, label := range labels {
+			if label == constants.ButtonReconfig {
+				buttons.SetActiveIndex(i)
+			}
+		}
 	}
 
 	return &ErrorView{
@@ -52,6 +90,12 @@ func NewErrorView(err *ErrorInfo) *ErrorView {
 	}
 }
 
+// UseModelLabel is the label of the button that switches to model and
+// retries
+func UseModelLabel(model string) string {
+	return fmt.Sprintf(constants.ButtonUseModel, model)
+}
+
 // SetSize updates dimensions
 func (v *ErrorView) SetSize(width, height int) {
 	v.width = width
@@ -64,6 +108,31 @@ func (v *ErrorView) SetError(err *ErrorInfo) {
 	v.error = err
 }
 
+// SetStatus shows a one-line message under the buttons
+func (v *ErrorView) SetStatus(status string) {
+	v.status = status
+}
+
+// SetInstalling marks a uv download started from this view as running
+func (v *ErrorView) SetInstalling(installing bool) {
+	v.installing = installing
+}
+
+// IsInstalling returns true while a uv download is running
+func (v *ErrorView) IsInstalling() bool {
+	return v.installing
+}
+
+// Details formats the error as plain text for bug reports
+func (e *ErrorInfo) Details() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Code:       %s\n", e.Code)
+	fmt.Fprintf(&b, "Title:      %s\n", e.Title)
+	fmt.Fprintf(&b, "Message:    %s\n", e.Message)
+	fmt.Fprintf(&b, "Suggestion: %s\n", e.Suggestion)
+	return b.String()
+}
+
 // HandleLeft moves button focus left
 func (v *ErrorView) HandleLeft() {
 	v.buttonGroup.Previous()
@@ -143,6 +212,12 @@ func (v *ErrorView) Render() string {
 		Width(sectionWidth).
 		Align(lipgloss.Center).
 		Render(v.buttonGroup.Render())
+	if v.status != "" {
+		buttons += "\n\n" + lipgloss.NewStyle().
+			Width(sectionWidth).
+			Align(lipgloss.Center).
+			Render(styles.Muted.Render(v.status))
+	}
 
 	// Build the main content area
 	innerContent := lipgloss.JoinVertical(
@@ -167,6 +242,7 @@ func (v *ErrorView) Render() string {
 		Render(components.FooterHelp([]components.HelpItem{
 			{Key: constants.HelpKeyLeftRight, Desc: constants.HelpDescSelect},
 			{Key: constants.HelpKeyEnter, Desc: constants.HelpDescConfirm},
+			{Key: constants.HelpKeyC, Desc: constants.HelpDescCopyDetails},
 			{Key: constants.HelpKeyEsc, Desc: constants.HelpDescGoBack},
 			{Key: constants.HelpKeyCtrlC, Desc: constants.HelpDescQuit},
 		}))
@@ -197,6 +273,7 @@ func (v *ErrorView) GetHelpItems() []components.HelpItem {
 	return []components.HelpItem{
 		{Key: constants.HelpKeyLeftRight, Desc: constants.HelpDescSelectOption},
 		{Key: constants.HelpKeyEnter, Desc: constants.HelpDescConfirm},
+		{Key: constants.HelpKeyC, Desc: constants.HelpDescCopyDetails},
 		{Key: constants.HelpKeyEsc, Desc: constants.HelpDescGoBack},
 		{Key: constants.HelpKeyCtrlC, Desc: constants.HelpDescQuit},
 	}
@@ -249,13 +326,13 @@ var (
 		Retryable:  true,
 	}
 
-	ErrLocalModelNotFound = &ErrorInfo{
-		Code:       "LOCAL_MODEL_NOT_FOUND",
-		Title:      "Local Model Runtime Not Found",
-		Message:    "Could not detect Ollama or LM Studio running.",
-		Suggestion: "Start your local model server and try again.",
-		Severity:   SeverityWarning,
-		Retryable:  true,
+	ErrSkeneAuthExpired = &ErrorInfo{
+		Code:           "SKENE_AUTH_EXPIRED",
+		Title:          "Skene Sign-in Expired",
+		Message:        "Skene rejected the saved API key. It has most likely expired.",
+		Suggestion:     "Re-authenticate to sign in again in your browser. The model, project and analysis settings are kept, and the analysis restarts once you are signed in.",
+		Severity:       SeverityError,
+		Reauthenticate: true,
 	}
 
 	ErrAnalysisFailed = &ErrorInfo{
@@ -267,6 +344,24 @@ var (
 		Retryable:  true,
 	}
 
+	ErrGitNotFound = &ErrorInfo{
+		Code:       "GIT_NOT_FOUND",
+		Title:      "Git Not Found",
+		Message:    "git is required to analyze a repository by URL but was not found in your PATH.",
+		Suggestion: "Install git from git-scm.com or your package manager, or clone the repository yourself and pass its directory.",
+		Severity:   SeverityError,
+		Retryable:  false,
+	}
+
+	ErrCloneFailed = &ErrorInfo{
+		Code:       "CLONE_FAILED",
+		Title:      "Clone Failed",
+		Message:    "The repository could not be cloned.",
+		Suggestion: "Check the URL. For a private repository, make sure 'git clone' works in your terminal, e.g. with a credential helper or an SSH key loaded in your agent.",
+		Severity:   SeverityError,
+		Retryable:  true,
+	}
+
 	ErrUVInstallFailed = &ErrorInfo{
 		Code:       "UV_INSTALL_FAILED",
 		Title:      "uv Installation Failed",
@@ -0,0 +1,154 @@
+package views
+
+import (
+	"fmt"
+
+	"skene/internal/constants"
+	"skene/internal/services/config"
+	"skene/internal/services/history"
+	"skene/internal/tui/components"
+	"skene/internal/tui/styles"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// HistoryView lists past analyses, newest first
+type HistoryView struct {
+	width         int
+	height        int
+	entries       []history.Entry
+	selectedIndex int
+	header        *components.WizardHeader
+}
+
+// NewHistoryView creates a history view for entries
+func NewHistoryView(entries []history.Entry) *HistoryView {
+	return &HistoryView{
+		entries: entries,
+		header:  components.NewTitleHeader(constants.StepNameHistory),
+	}
+}
+
+// SetSize updates dimensions
+func (v *HistoryView) SetSize(width, height int) {
+	v.width = width
+	v.height = height
+	v.header.SetWidth(width)
+}
+
+// HandleUp moves selection up
+func (v *HistoryView) HandleUp() {
+	if v.selectedIndex > 0 {
+		v.selectedIndex--
+	}
+}
+
+// HandleDown moves selection down
+func (v *HistoryView) HandleDown() {
+	if v.selectedIndex < len(v.entries)-1 {
+		v.selectedIndex++
+	}
+}
+
+// GetSelectedEntry returns the selected analysis, or nil when there are none
+func (v *HistoryView) GetSelectedEntry() *history.Entry {
+	if v.selectedIndex < 0 || v.selectedIndex >= len(v.entries) {
+		return nil
+	}
+	return &v.entries[v.selectedIndex]
+}
+
+// Render the history view
+func (v *HistoryView) Render() string {
+	sectionWidth := v.width - 20
+	if sectionWidth < 60 {
+		sectionWidth = 60
+	}
+	if sectionWidth > 80 {
+		sectionWidth = 80
+	}
+
+	wizHeader := lipgloss.NewStyle().Width(sectionWidth).Render(v.header.Render())
+
+	footer := lipgloss.NewStyle().
+		Width(v.width).
+		Align(lipgloss.Center).
+		Render(components.FooterHelp(v.GetHelpItems()))
+
+	content := lipgloss.JoinVertical(
+		lipgloss.Left,
+		wizHeader,
+		"",
+		v.renderList(sectionWidth),
+	)
+
+	padded := lipgloss.NewStyle().PaddingTop(2).Render(content)
+
+	centered := lipgloss.Place(
+		v.width,
+		v.height-3,
+		lipgloss.Center,
+		lipgloss.Top,
+		padded,
+	)
+
+	return centered + "\n" + footer
+}
+
+func (v *HistoryView) renderList(width int) string {
+	if len(v.entries) == 0 {
+		return styles.Box.Width(width).Render(styles.Muted.Render(constants.HistoryEmpty))
+	}
+
+	count := styles.Muted.Render(fmt.Sprintf(constants.HistoryCount, v.selectedIndex+1, len(v.entries)))
+
+	// Each entry takes 3 lines
+	start, end := listWindow(v.selectedIndex, len(v.entries), (v.height-18)/3)
+	innerWidth := width - 8
+	var items []string
+	for i := start; i < end; i++ {
+		e := v.entries[i]
+		project := config.GetShortenedPath(e.ProjectDir, innerWidth)
+		detail := fmt.Sprintf("%s · %s / %s · %s",
+			e.Timestamp.Local().Format("2006-01-02 15:04"),
+			e.Provider, e.Model,
+			config.GetShortenedPath(e.OutputDir, constants.StatusLinePathMax))
+
+		descStyle := lipgloss.NewStyle().Foreground(styles.MidGray).PaddingLeft(2).MaxWidth(innerWidth + 2)
+		var name string
+		if i == v.selectedIndex {
+			name = styles.ListItemSelected.Render(project)
+			descStyle = descStyle.Foreground(styles.Sand)
+		} else {
+			name = styles.ListItem.Render(project)
+		}
+		items = append(items, name+"\n"+descStyle.Render(detail))
+		if i < end-1 {
+			items = append(items, "")
+		}
+	}
+
+	return styles.Box.Width(width).Render(lipgloss.JoinVertical(
+		lipgloss.Left,
+		count,
+		"",
+		lipgloss.JoinVertical(lipgloss.Left, items...),
+	))
+}
+
+// GetHelpItems returns context-specific help
+func (v *HistoryView) GetHelpItems() []components.HelpItem {
+	if len(v.entries) == 0 {
+		return []components.HelpItem{
+			{Key: constants.HelpKeyEsc, Desc: constants.HelpDescGoBack},
+			{Key: constants.HelpKeyCtrlC, Desc: constants.HelpDescQuit},
+		}
+	}
+	return []components.HelpItem{
+		{Key: constants.HelpKeyUpDown, Desc: constants.HelpDescNavigate},
+		{Key: constants.HelpKeyEnter, Desc: constants.HelpDescViewResults},
+		{Key: constants.HelpKeyO, Desc: constants.HelpDescOpenFolder},
+		{Key: constants.HelpKeyEsc, Desc: constants.HelpDescGoBack},
+		{Key: constants.HelpKeyCtrlC, Desc: constants.HelpDescQuit},
+	}
+}
@@ -2,6 +2,8 @@ package views
 
 import (
 	"fmt"
+	"time"
+
 	"skene/internal/constants"
 	"skene/internal/services/config"
 	"skene/internal/tui/components"
@@ -17,21 +19,81 @@ type ModelView struct {
 	provider      *config.Provider
 	selectedIndex int
 	header        *components.WizardHeader
+
+	// Models on offer: the cached live list when one was fetched,
+	// otherwise the provider's built-in list
+	models    []config.Model
+	fetchedAt time.Time // zero for the built-in list
+	fetching  bool
+	status    string // why the last fetch failed, or could not start
 }
 
 // NewModelView creates a new model view
 func NewModelView(provider *config.Provider) *ModelView {
-	return &ModelView{
-		provider:      provider,
+	v := &ModelView{
 		selectedIndex: 0,
 		header:        components.NewWizardHeader(1, constants.StepNameSelectModel),
 	}
+	v.SetProvider(provider)
+	return v
 }
 
 // SetProvider updates the provider
 func (v *ModelView) SetProvider(provider *config.Provider) {
 	v.provider = provider
 	v.selectedIndex = 0
+	v.models = config.ProviderModels(provider)
+	v.fetchedAt = time.Time{}
+	if provider != nil {
+		if _, at := config.CachedModels(provider.ID); len(v.models) > 0 && !at.IsZero() {
+			v.fetchedAt = at
+		}
+	}
+}
+
+// SetFetching shows that the latest models are being fetched
+func (v *ModelView) SetFetching() {
+	v.fetching = true
+	v.status = ""
+}
+
+// SetModels replaces the list with models fetched from the provider,
+// keeping the selected model where it is still listed
+func (v *ModelView) SetModels(models []config.Model, fetchedAt time.Time) {
+	selected := ""
+	if m := v.GetSelectedModel(); m != nil {
+		selected = m.ID
+	}
+	v.models = models
+	v.fetchedAt = fetchedAt
+	v.fetching = false
+	v.status = ""
+	v.selectedIndex = 0
+	v.SelectModel(selected)
+}
+
+// SetStatus reports why the models could not be fetched; the current list stays
+func (v *ModelView) SetStatus(status string) {
+	v.fetching = false
+	v.status = status
+}
+
+// IsFetching returns true while a fetch is running
+func (v *ModelView) IsFetching() bool {
+	return v.fetching
+}
+
+// SelectModel pre-selects the model with the given ID, if the provider has it
+func (v *ModelView) SelectModel(id string) {
+	if v.provider == nil {
+		return
+	}
+	for i, m := range v.models {
+		if m.ID == id {
+			v.selectedIndex = i
+			return
+		}
+	}
 }
 
 // SetSize updates dimensions
@@ -53,17 +115,17 @@ func (v *ModelView) HandleDown() {
 	if v.provider == nil {
 		return
 	}
-	if v.selectedIndex < len(v.provider.Models)-1 {
+	if v.selectedIndex < len(v.models)-1 {
 		v.selectedIndex++
 	}
 }
 
 // GetSelectedModel returns the selected model
 func (v *ModelView) GetSelectedModel() *config.Model {
-	if v.provider == nil || v.selectedIndex < 0 || v.selectedIndex >= len(v.provider.Models) {
+	if v.provider == nil || v.selectedIndex < 0 || v.selectedIndex >= len(v.models) {
 		return nil
 	}
-	return &v.provider.Models[v.selectedIndex]
+	return &v.models[v.selectedIndex]
 }
 
 // Render the model view
@@ -90,7 +152,7 @@ func (v *ModelView) Render() string {
 	footer := lipgloss.NewStyle().
 		Width(v.width).
 		Align(lipgloss.Center).
-		Render(components.WizardSelectHelp())
+		Render(components.FooterHelp(v.GetHelpItems()))
 
 	// Combine
 	content := lipgloss.JoinVertical(
@@ -116,13 +178,26 @@ func (v *ModelView) Render() string {
 func (v *ModelView) renderModelList(width int) string {
 	header := styles.SectionHeader.Render(fmt.Sprintf("Select Model for %s", v.provider.Name))
 
-	// Model count
-	count := styles.Muted.Render(fmt.Sprintf("%d / %d models", v.selectedIndex+1, len(v.provider.Models)))
+	// Model count and where the list came from
+	source := constants.ModelListBuiltIn
+	if !v.fetchedAt.IsZero() {
+		source = fmt.Sprintf(constants.ModelListLive, v.fetchedAt.Local().Format("2006-01-02 15:04"))
+	}
+	count := styles.Muted.Render(fmt.Sprintf("%d / %d models · %s", v.selectedIndex+1, len(v.models), source))
+	var status string
+	switch {
+	case v.fetching:
+		status = styles.Accent.Render(constants.ModelListFetching)
+	case v.status != "":
+		status = styles.Error.Width(width - 6).Render(v.status)
+	}
 
-	// Model list
+	// Model list, windowed around the selection; each item takes 3 lines
+	start, end := listWindow(v.selectedIndex, len(v.models), (v.height-20)/3)
 	descWidth := width - 8
 	var items []string
-	for i, m := range v.provider.Models {
+	for i := start; i < end; i++ {
+		m := v.models[i]
 		isSelected := i == v.selectedIndex
 
 		var item string
@@ -138,22 +213,39 @@ func (v *ModelView) renderModelList(width int) string {
 		items = append(items, item)
 
 		// Add spacing between items (but not after last)
-		if i < len(v.provider.Models)-1 {
+		if i < end-1 {
 			items = append(items, "")
 		}
 	}
 
 	list := lipgloss.JoinVertical(lipgloss.Left, items...)
 
-	content := lipgloss.JoinVertical(
-		lipgloss.Left,
-		header,
-		count,
-		"",
-		list,
-	)
+	lines := []string{header, count}
+	if status != "" {
+		lines = append(lines, status)
+	}
+	lines = append(lines, "", list)
+
+	return styles.Box.Width(width).Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
 
-	return styles.Box.Width(width).Render(content)
+// listWindow returns the range of a list of n items to show so that
+// selected stays visible with at most visible items (at least 3) on screen
+func listWindow(selected, n, visible int) (start, end int) {
+	if visible < 3 {
+		visible = 3
+	}
+	if n <= visible {
+		return 0, n
+	}
+	start = selected - visible/2
+	if start < 0 {
+		start = 0
+	}
+	if start > n-visible {
+		start = n - visible
+	}
+	return start, start + visible
 }
 
 // GetHelpItems returns context-specific help
@@ -161,6 +253,7 @@ func (v *ModelView) GetHelpItems() []components.HelpItem {
 	return []components.HelpItem{
 		{Key: constants.HelpKeyUpDown, Desc: constants.HelpDescSelectModel},
 		{Key: constants.HelpKeyEnter, Desc: constants.HelpDescConfirmSelection},
+		{Key: constants.HelpKeyF, Desc: constants.HelpDescFetchModels},
 		{Key: constants.HelpKeyEsc, Desc: constants.HelpDescGoBack},
 		{Key: constants.HelpKeyCtrlC, Desc: constants.HelpDescQuit},
 	}
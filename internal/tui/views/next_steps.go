@@ -23,6 +23,12 @@ type NextStepsView struct {
 	actions     []NextStepAction
 	selectedIdx int
 	header      *components.WizardHeader
+
+	// Output files written by the last run; tab moves focus to this list
+	files      []string
+	fileIdx    int
+	focusFiles bool
+	status     string
 }
 
 // NewNextStepsView creates a new next steps view
@@ -52,8 +58,51 @@ func (v *NextStepsView) SetSize(width, height int) {
 	v.header.SetWidth(width)
 }
 
+// SetOutputFiles sets the absolute paths listed under Output Files
+func (v *NextStepsView) SetOutputFiles(files []string) {
+	v.files = files
+	if v.fileIdx >= len(files) {
+		v.fileIdx = 0
+	}
+	if len(files) == 0 {
+		v.focusFiles = false
+	}
+}
+
+// SetStatus shows a one-line message under the file list
+func (v *NextStepsView) SetStatus(status string) {
+	v.status = status
+}
+
+// HandleTab switches focus between the actions and the file list
+func (v *NextStepsView) HandleTab() {
+	if len(v.files) > 0 {
+		v.focusFiles = !v.focusFiles
+	}
+	v.status = ""
+}
+
+// IsFilesFocused returns true when the file list has focus
+func (v *NextStepsView) IsFilesFocused() bool {
+	return v.focusFiles
+}
+
+// GetSelectedFile returns the highlighted output file path
+func (v *NextStepsView) GetSelectedFile() string {
+	if v.fileIdx >= 0 && v.fileIdx < len(v.files) {
+		return v.files[v.fileIdx]
+	}
+	return ""
+}
+
 // HandleUp moves selection up
 func (v *NextStepsView) HandleUp() {
+	if v.focusFiles {
+		if v.fileIdx > 0 {
+			v.fileIdx--
+		}
+		return
+	}
 	if v.selectedIdx > 0 {
 		v.selectedIdx--
 	}
@@ -61,6 +110,12 @@ func (v *NextStepsView) HandleUp() {
 
 // HandleDown moves selection down
 func (v *NextStepsView) HandleDown() {
+	if v.focusFiles {
+		if v.fileIdx < len(v.files)-1 {
+			v.fileIdx++
+		}
+		return
+	}
 	if v.selectedIdx < len(v.actions)-1 {
 		v.selectedIdx++
 	}
@@ -93,6 +148,9 @@ func (v *NextStepsView) Render() string {
 	// Actions list
 	actionsSection := v.renderActions(sectionWidth)
 
+	// Output files
+	filesSection := v.renderFiles(sectionWidth)
+
 	// Command preview
 	commandPreview := v.renderCommandPreview(sectionWidth)
 
@@ -100,7 +158,7 @@ func (v *NextStepsView) Render() string {
 	footer := lipgloss.NewStyle().
 		Width(v.width).
 		Align(lipgloss.Center).
-		Render(components.WizardSelectHelp())
+		Render(components.FooterHelp(v.GetHelpItems()))
 
 	// Combine
 	content := lipgloss.JoinVertical(
@@ -111,6 +169,8 @@ func (v *NextStepsView) Render() string {
 		"",
 		actionsSection,
 		"",
+		filesSection,
+		"",
 		commandPreview,
 	)
 
@@ -132,7 +192,7 @@ func (v *NextStepsView) renderActions(width int) string {
 
 	descWidth := width - 8
 	for i, action := range v.actions {
-		isSelected := i == v.selectedIdx
+		isSelected := i == v.selectedIdx && !v.focusFiles
 
 		var name, desc string
 		if isSelected {
@@ -156,9 +216,38 @@ func (v *NextStepsView) renderActions(width int) string {
 	return styles.Box.Width(width).Render(list)
 }
 
+func (v *NextStepsView) renderFiles(width int) string {
+	header := styles.SectionHeader.Render(constants.NextStepsFilesHeader)
+
+	var rows []string
+	if len(v.files) == 0 {
+		rows = append(rows, styles.Muted.Render(constants.NextStepsNoFiles))
+	}
+	pathWidth := width - 8
+	for i, path := range v.files {
+		row := lipgloss.NewStyle().Width(pathWidth).Render(path)
+		if v.focusFiles && i == v.fileIdx {
+			rows = append(rows, styles.ListItemSelected.Render(row))
+		} else {
+			rows = append(rows, styles.ListItem.Render(row))
+		}
+	}
+	if v.status != "" {
+		rows = append(rows, "", styles.Muted.Render(v.status))
+	}
+
+	content := lipgloss.JoinVertical(
+		lipgloss.Left,
+		header,
+		"",
+		lipgloss.JoinVertical(lipgloss.Left, rows...),
+	)
+	return styles.Box.Width(width).Render(content)
+}
+
 func (v *NextStepsView) renderCommandPreview(width int) string {
 	action := v.GetSelectedAction()
-	if action == nil || action.Command == "" {
+	if v.focusFiles || action == nil || action.Command == "" {
 		return ""
 	}
 
@@ -173,9 +262,19 @@ func (v *NextStepsView) renderCommandPreview(width int) string {
 
 // GetHelpItems returns context-specific help
 func (v *NextStepsView) GetHelpItems() []components.HelpItem {
+	if v.focusFiles {
+		return []components.HelpItem{
+			{Key: constants.HelpKeyUpDown, Desc: constants.HelpDescNavigate},
+			{Key: constants.HelpKeyEnter, Desc: constants.HelpDescOpenFile},
+			{Key: constants.HelpKeyC, Desc: constants.HelpDescCopyPath},
+			{Key: constants.HelpKeyTab, Desc: constants.HelpDescSwitchFocus},
+			{Key: constants.HelpKeyEsc, Desc: constants.HelpDescBackToResults},
+		}
+	}
 	return []components.HelpItem{
 		{Key: constants.HelpKeyUpDown, Desc: constants.HelpDescNavigate},
 		{Key: constants.HelpKeyEnter, Desc: constants.HelpDescSelect},
+		{Key: constants.HelpKeyTab, Desc: constants.HelpDescSwitchFocus},
 		{Key: constants.HelpKeyEsc, Desc: constants.HelpDescBackToResults},
 		{Key: constants.HelpKeyCtrlC, Desc: constants.HelpDescQuit},
 	}
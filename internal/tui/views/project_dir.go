@@ -1,13 +1,17 @@
 package views
 
 import (
+	"errors"
+	"net/url"
 	"os"
 	"path/filepath"
 	"skene/internal/constants"
 	"skene/internal/tui/components"
 	"skene/internal/tui/styles"
+	"strings"
 
 	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
 
@@ -98,6 +102,10 @@ func (v *ProjectDirView) SetSize(width, height int) {
 func (v *ProjectDirView) Update(msg interface{}) {
 	if v.inputFocus {
 		v.textInput, _ = v.textInput.Update(msg)
+		// Show a pasted path the way it will be used
+		if key, ok := msg.(tea.KeyMsg); ok && key.Paste {
+			v.textInput.SetValue(cleanPastedPath(v.textInput.Value()))
+		}
 		v.validatePath()
 	}
 }
@@ -158,6 +166,7 @@ func (v *ProjectDirView) UseCurrentDir() {
 func (v *ProjectDirView) StartBrowsing() {
 	startPath := v.GetProjectDir()
 	v.dirBrowser = components.NewDirBrowser(startPath)
+	v.dirBrowser.SetMarkProjects(true)
 	browserHeight := v.height - 16
 	if browserHeight < 6 {
 		browserHeight = 6
@@ -238,33 +247,56 @@ func (v *ProjectDirView) HandleBrowseRight() {
 	}
 }
 
-// HandleBrowseKey handles key input for the directory listing
-func (v *ProjectDirView) HandleBrowseKey(key string) {
-	if v.dirBrowser == nil {
-		return
-	}
-	switch key {
-	case "up", "k":
+// BrowseUp moves the directory listing cursor up
+func (v *ProjectDirView) BrowseUp() {
+	if v.dirBrowser != nil {
 		v.dirBrowser.CursorUp()
-	case "down", "j":
+	}
+}
+
+// BrowseDown moves the directory listing cursor down
+func (v *ProjectDirView) BrowseDown() {
+	if v.dirBrowser != nil {
 		v.dirBrowser.CursorDown()
-	case "enter":
-		if v.dirBrowser.SelectedIsDir() {
-			v.dirBrowser.Enter()
-		}
-	case "backspace":
+	}
+}
+
+// BrowseEnter opens the selected directory
+func (v *ProjectDirView) BrowseEnter() {
+	if v.dirBrowser != nil && v.dirBrowser.SelectedIsDir() {
+		v.dirBrowser.Enter()
+	}
+}
+
+// BrowseParent moves the listing to the parent directory
+func (v *ProjectDirView) BrowseParent() {
+	if v.dirBrowser != nil {
 		v.dirBrowser.GoUp()
-	case ".":
+	}
+}
+
+// ToggleHidden shows or hides dotfiles in the listing, whichever part of
+// the browser has focus
+func (v *ProjectDirView) ToggleHidden() {
+	if v.dirBrowser != nil {
 		v.dirBrowser.ToggleHidden()
 	}
 }
 
-// GetProjectDir returns the entered/selected directory
+// GetProjectDir returns the entered/selected directory, with paste
+// artifacts removed and ~ and environment variables such as $HOME expanded
 func (v *ProjectDirView) GetProjectDir() string {
-	val := v.textInput.Value()
+	val := cleanPastedPath(v.textInput.Value())
 	if val == "" {
 		return v.currentDir
 	}
+	// Unset variables are kept as typed so the error names them
+	val = os.Expand(val, func(name string) string {
+		if value, ok := os.LookupEnv(name); ok {
+			return value
+		}
+		return "$" + name
+	})
 	if len(val) > 0 && val[0] == '~' {
 		home, _ := os.UserHomeDir()
 		val = filepath.Join(home, val[1:])
@@ -272,6 +304,26 @@ func (v *ProjectDirView) GetProjectDir() string {
 	return val
 }
 
+// cleanPastedPath strips what file managers and terminals add when a path
+// is copied: surrounding whitespace and newlines, quotes, and a file://
+// scheme with its percent-encoding
+func cleanPastedPath(val string) string {
+	val = strings.TrimSpace(val)
+	if len(val) >= 2 && (val[0] == '"' || val[0] == '\'') && val[len(val)-1] == val[0] {
+		val = strings.TrimSpace(val[1 : len(val)-1])
+	}
+	if strings.HasPrefix(strings.ToLower(val), "file://") {
+		if u, err := url.Parse(val); err == nil && u.Path != "" {
+			val = u.Path
+			// file:///C:/work is /C:/work once parsed
+			if len(val) >= 3 && val[0] == '/' && val[2] == ':' {
+				val = val[1:]
+			}
+		}
+	}
+	return val
+}
+
 // IsValid returns if the path is valid
 func (v *ProjectDirView) IsValid() bool {
 	return v.isValid
@@ -337,21 +389,30 @@ func (v *ProjectDirView) HasExistingAnalysis() bool {
 	return v.hasSkeneContext
 }
 
-func (v *ProjectDirView) validatePath() {
-	path := v.GetProjectDir()
-
+// ValidateProjectDir checks that path exists and is a directory
+func ValidateProjectDir(path string) error {
 	info, err := os.Stat(path)
 	if err != nil {
-		v.isValid = false
-		v.validMsg = constants.ProjectDirNotFound
-		v.warningMsg = ""
-		v.hasSkeneContext = false
-		return
+		return errors.New(constants.ProjectDirNotFound)
 	}
-
 	if !info.IsDir() {
+		return errors.New(constants.ProjectDirNotADir)
+	}
+	return nil
+}
+
+// SetProjectDir pre-fills the path input and validates it
+func (v *ProjectDirView) SetProjectDir(path string) {
+	v.textInput.SetValue(path)
+	v.validatePath()
+}
+
+func (v *ProjectDirView) validatePath() {
+	path := v.GetProjectDir()
+
+	if err := ValidateProjectDir(path); err != nil {
 		v.isValid = false
-		v.validMsg = constants.ProjectDirNotADir
+		v.validMsg = err.Error()
 		v.warningMsg = ""
 		v.hasSkeneContext = false
 		return
@@ -369,20 +430,7 @@ func (v *ProjectDirView) validatePath() {
 	}
 
 	// Check for common project indicators
-	hasProject := false
-	projectMarkers := []string{
-		"package.json", "pyproject.toml", "requirements.txt",
-		"go.mod", "Cargo.toml", "pom.xml", "build.gradle",
-		".git", "Makefile",
-	}
-	for _, marker := range projectMarkers {
-		if _, err := os.Stat(filepath.Join(path, marker)); err == nil {
-			hasProject = true
-			break
-		}
-	}
-
-	if !hasProject {
+	if !components.HasProjectMarker(path) {
 		v.warningMsg = constants.ProjectDirNoProject
 	} else {
 		v.warningMsg = ""
@@ -416,6 +464,7 @@ func (v *ProjectDirView) Render() string {
 			Render(components.FooterHelp([]components.HelpItem{
 				{Key: constants.HelpKeyUpDown, Desc: constants.HelpDescNavigate},
 				{Key: constants.HelpKeyEnter, Desc: constants.HelpDescOpenFolder},
+				{Key: constants.HelpKeyDot, Desc: constants.HelpDescHiddenFiles},
 				{Key: constants.HelpKeyTab, Desc: constants.HelpDescSwitchFocus},
 				{Key: constants.HelpKeyEsc, Desc: constants.HelpDescCancel},
 			}))
@@ -19,6 +19,9 @@ type ProviderView struct {
 	scrollOffset  int
 	maxVisible    int
 	header        *components.WizardHeader
+
+	// Reachability per provider ID; missing means not checked yet
+	health map[string]bool
 }
 
 // NewProviderView creates a new provider view
@@ -28,7 +31,25 @@ func NewProviderView() *ProviderView {
 		selectedIndex: 0,
 		maxVisible:    7,
 		header:        components.NewWizardHeader(1, constants.StepNameAIProvider),
+		health:        make(map[string]bool),
+	}
+}
+
+// SetProviderHealth records whether a provider's API answered the probe
+func (v *ProviderView) SetProviderHealth(providerID string, reachable bool) {
+	v.health[providerID] = reachable
+}
+
+// renderHealthDot returns a green or red dot once a provider was probed
+func (v *ProviderView) renderHealthDot(providerID string) string {
+	reachable, ok := v.health[providerID]
+	if !ok {
+		return ""
 	}
+	if reachable {
+		return " " + lipgloss.NewStyle().Foreground(styles.Success).Render("●")
+	}
+	return " " + lipgloss.NewStyle().Foreground(styles.Coral).Render("●")
 }
 
 // SetSize updates dimensions
@@ -44,6 +65,28 @@ func (v *ProviderView) SetSize(width, height int) {
 	if v.maxVisible > 10 {
 		v.maxVisible = 10
 	}
+	v.scrollToSelection()
+}
+
+// SelectProvider pre-selects the provider with the given ID, if any
+func (v *ProviderView) SelectProvider(id string) {
+	for i, p := range v.providers {
+		if p.ID == id {
+			v.selectedIndex = i
+			v.scrollToSelection()
+			return
+		}
+	}
+}
+
+// scrollToSelection keeps the selected provider inside the visible window
+func (v *ProviderView) scrollToSelection() {
+	if v.selectedIndex < v.scrollOffset {
+		v.scrollOffset = v.selectedIndex
+	}
+	if v.selectedIndex >= v.scrollOffset+v.maxVisible {
+		v.scrollOffset = v.selectedIndex - v.maxVisible + 1
+	}
 }
 
 // HandleUp moves selection up
@@ -138,11 +181,11 @@ func (v *ProviderView) renderProviderList(width int) string {
 
 		var item string
 		if isSelected {
-			name := styles.ListItemSelected.Render(p.Name)
+			name := styles.ListItemSelected.Render(p.Name) + v.renderHealthDot(p.ID)
 			desc := lipgloss.NewStyle().Foreground(styles.Sand).PaddingLeft(2).Width(descWidth).Render(p.Description)
 			item = name + "\n" + desc
 		} else {
-			name := styles.ListItem.Render(p.Name)
+			name := styles.ListItem.Render(p.Name) + v.renderHealthDot(p.ID)
 			desc := lipgloss.NewStyle().Foreground(styles.MidGray).PaddingLeft(2).Width(descWidth).Render(p.Description)
 			item = name + "\n" + desc
 		}
@@ -1,14 +1,20 @@
 package views
 
 import (
+	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
 	"skene/internal/constants"
+	"skene/internal/services/growth"
 	"skene/internal/tui/components"
 	"skene/internal/tui/styles"
+	"strings"
 
 	"github.com/charmbracelet/bubbles/viewport"
+	"github.com/charmbracelet/glamour"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
 )
 
 // ResultsFocus represents which element is focused
@@ -26,9 +32,31 @@ type ResultsView struct {
 	tabs      []string
 	activeTab int
 	contents  map[string]string
+	generated map[string]bool
 	viewport  viewport.Model
 	focus     ResultsFocus
 	header    *components.WizardHeader
+
+	// Structured growth plan; empty when the plan could not be parsed
+	loops        []growth.GrowthLoop
+	selectedLoop int
+	showRawPlan  bool
+
+	// Section navigation for Markdown tabs. headingLines holds the
+	// viewport line of each heading in the rendered content.
+	headings        []growth.Heading
+	headingLines    []int
+	showTOC         bool
+	selectedHeading int
+
+	// Markdown renderer, rebuilt when the viewport width changes
+	renderer      *glamour.TermRenderer
+	rendererWidth int
+
+	// Skene dashboard offered after a Skene analysis, and the note shown
+	// when it could not be opened
+	dashboardURL  string
+	dashboardNote string
 }
 
 // NewResultsView creates a new results view with default placeholder content
@@ -43,9 +71,10 @@ func NewResultsViewWithContent(growthPlan, manifest, growthTemplate string) *Res
 	vp := viewport.New(60, 20)
 
 	v := &ResultsView{
-		tabs:      []string{constants.TabGrowthManifest, constants.TabGrowthTemplate, constants.TabGrowthPlan},
+		tabs:      resultTabs,
 		activeTab: 0,
 		contents:  make(map[string]string),
+		generated: make(map[string]bool),
 		viewport:  vp,
 		focus:     ResultsFocusTabs,
 		header:    components.NewTitleHeader(constants.StepNameResults),
@@ -53,22 +82,26 @@ func NewResultsViewWithContent(growthPlan, manifest, growthTemplate string) *Res
 
 	if manifest != "" {
 		v.contents[constants.TabGrowthManifest] = manifest
+		v.generated[constants.TabGrowthManifest] = true
 	} else {
 		v.contents[constants.TabGrowthManifest] = constants.PlaceholderGrowthManifest
 	}
 	if growthTemplate != "" {
 		v.contents[constants.TabGrowthTemplate] = growthTemplate
+		v.generated[constants.TabGrowthTemplate] = true
 	} else {
 		v.contents[constants.TabGrowthTemplate] = constants.PlaceholderGrowthTemplate
 	}
 	if growthPlan != "" {
 		v.contents[constants.TabGrowthPlan] = growthPlan
+		v.generated[constants.TabGrowthPlan] = true
+		v.loops = growth.ParseGrowthLoops(growthPlan)
 	} else {
 		v.contents[constants.TabGrowthPlan] = constants.PlaceholderGrowthPlan
 	}
+	v.rebuildTabs()
 
-	wrapped := lipgloss.NewStyle().Width(v.viewport.Width).Render(v.contents[constants.TabGrowthManifest])
-	v.viewport.SetContent(wrapped)
+	v.viewport.SetContent(v.renderContent(v.contents[v.tabs[v.activeTab]]))
 
 	return v
 }
@@ -78,16 +111,24 @@ func (v *ResultsView) SetSize(width, height int) {
 	v.width = width
 	v.height = height
 	v.header.SetWidth(width)
+	v.resizeViewport()
+}
 
-	vpWidth := width - 10
+// resizeViewport fits the viewport to the view, leaving room for the
+// contents panel when it is open, and re-renders the active tab
+func (v *ResultsView) resizeViewport() {
+	vpWidth := v.width - 10
 	if vpWidth < 40 {
 		vpWidth = 40
 	}
 	if vpWidth > 100 {
 		vpWidth = 100
 	}
+	if v.showTOC {
+		vpWidth -= constants.ResultsTOCWidth + 1
+	}
 
-	vpHeight := height - 16
+	vpHeight := v.height - 18
 	if vpHeight < 10 {
 		vpHeight = 10
 	}
@@ -97,6 +138,37 @@ func (v *ResultsView) SetSize(width, height int) {
 	v.updateContent()
 }
 
+// resultTabs lists every tab in display order
+var resultTabs = []string{constants.TabGrowthManifest, constants.TabGrowthTemplate, constants.TabGrowthPlan}
+
+// rebuildTabs shows only tabs for artifacts that were generated, keeping
+// the active tab where possible. With no artifacts at all every tab is shown
+// with its placeholder.
+func (v *ResultsView) rebuildTabs() {
+	current := v.tabs[v.activeTab]
+
+	var tabs []string
+	for _, tab := range resultTabs {
+		if v.generated[tab] {
+			tabs = append(tabs, tab)
+		}
+	}
+	if len(tabs) == 0 {
+		tabs = append(tabs, resultTabs...)
+	}
+	if v.generated[constants.TabChanges] {
+		tabs = append(tabs, constants.TabChanges)
+	}
+	v.tabs = tabs
+
+	v.activeTab = 0
+	for i, tab := range tabs {
+		if tab == current {
+			v.activeTab = i
+		}
+	}
+}
+
 // HandleLeft moves tab left
 func (v *ResultsView) HandleLeft() {
 	if v.focus == ResultsFocusTabs && v.activeTab > 0 {
@@ -113,20 +185,188 @@ func (v *ResultsView) HandleRight() {
 	}
 }
 
-// HandleUp scrolls content up
+// HandleUp scrolls content up, or selects the previous growth loop or
+// section heading
 func (v *ResultsView) HandleUp() {
-	if v.focus == ResultsFocusContent {
-		v.viewport.LineUp(3)
+	if v.focus != ResultsFocusContent {
+		return
+	}
+	if v.showTOC {
+		if v.selectedHeading > 0 {
+			v.selectedHeading--
+			v.jumpToHeading()
+		}
+		return
+	}
+	if v.showingLoops() {
+		if v.selectedLoop > 0 {
+			v.selectedLoop--
+			v.renderLoopContent()
+		}
+		return
 	}
+	v.viewport.LineUp(3)
 }
 
-// HandleDown scrolls content down
+// HandleDown scrolls content down, or selects the next growth loop or
+// section heading
 func (v *ResultsView) HandleDown() {
+	if v.focus != ResultsFocusContent {
+		return
+	}
+	if v.showTOC {
+		if v.selectedHeading < len(v.headings)-1 {
+			v.selectedHeading++
+			v.jumpToHeading()
+		}
+		return
+	}
+	if v.showingLoops() {
+		if v.selectedLoop < len(v.loops)-1 {
+			v.selectedLoop++
+			v.renderLoopContent()
+		}
+		return
+	}
+	v.viewport.LineDown(3)
+}
+
+// HandlePageUp scrolls content up by one viewport height
+func (v *ResultsView) HandlePageUp() {
+	if v.focus == ResultsFocusContent {
+		v.viewport.ViewUp()
+	}
+}
+
+// HandlePageDown scrolls content down by one viewport height
+func (v *ResultsView) HandlePageDown() {
 	if v.focus == ResultsFocusContent {
-		v.viewport.LineDown(3)
+		v.viewport.ViewDown()
+	}
+}
+
+// HandleHome scrolls to the top of the content, or selects the first
+// growth loop or section heading
+func (v *ResultsView) HandleHome() {
+	if v.focus != ResultsFocusContent {
+		return
+	}
+	switch {
+	case v.showTOC:
+		v.selectedHeading = 0
+		v.jumpToHeading()
+	case v.showingLoops():
+		v.selectedLoop = 0
+		v.renderLoopContent()
+		v.viewport.GotoTop()
+	default:
+		v.viewport.GotoTop()
 	}
 }
 
+// HandleEnd scrolls to the bottom of the content, or selects the last
+// growth loop or section heading
+func (v *ResultsView) HandleEnd() {
+	if v.focus != ResultsFocusContent {
+		return
+	}
+	switch {
+	case v.showTOC:
+		v.selectedHeading = len(v.headings) - 1
+		v.jumpToHeading()
+	case v.showingLoops():
+		v.selectedLoop = len(v.loops) - 1
+		v.renderLoopContent()
+	default:
+		v.viewport.GotoBottom()
+	}
+}
+
+// ToggleRawPlan switches the growth plan tab between the loop list and raw text
+func (v *ResultsView) ToggleRawPlan() {
+	if v.tabs[v.activeTab] != constants.TabGrowthPlan || len(v.loops) == 0 {
+		return
+	}
+	v.showRawPlan = !v.showRawPlan
+	v.updateContent()
+}
+
+// ToggleTOC opens or closes the contents panel listing the headings of the
+// active tab. Opening it selects the heading at the current scroll position
+// and focuses the content; closing it leaves the viewport on that section.
+func (v *ResultsView) ToggleTOC() {
+	if !v.showTOC && len(v.headings) == 0 {
+		return
+	}
+	selected := v.selectedHeading
+	if !v.showTOC {
+		selected = v.headingAt(v.viewport.YOffset)
+		v.focus = ResultsFocusContent
+	}
+	v.showTOC = !v.showTOC
+	v.resizeViewport()
+	// Re-rendering at the new width moves the headings
+	if selected < len(v.headings) {
+		v.selectedHeading = selected
+		v.jumpToHeading()
+	}
+}
+
+// IsTOCOpen returns true if the contents panel is shown
+func (v *ResultsView) IsTOCOpen() bool {
+	return v.showTOC
+}
+
+// headingAt returns the last heading at or above line
+func (v *ResultsView) headingAt(line int) int {
+	index := 0
+	for i, start := range v.headingLines {
+		if start <= line {
+			index = i
+		}
+	}
+	return index
+}
+
+func (v *ResultsView) jumpToHeading() {
+	if v.selectedHeading < len(v.headingLines) {
+		v.viewport.SetYOffset(v.headingLines[v.selectedHeading])
+	}
+}
+
+// indexHeadings records where each Markdown heading of source landed in
+// the rendered viewport content. Headings are matched in order on their
+// first characters, since long titles may be wrapped; any that cannot be
+// found are left out of the contents.
+func (v *ResultsView) indexHeadings(source, rendered string) {
+	v.headings, v.headingLines = nil, nil
+	if json.Valid([]byte(strings.TrimSpace(source))) {
+		return
+	}
+
+	lines := strings.Split(ansi.Strip(rendered), "\n")
+	next := 0
+	for _, h := range growth.ParseHeadings(source) {
+		prefix := ansi.Truncate(h.Title, constants.ResultsHeadingMatchLen, "")
+		for i := next; i < len(lines); i++ {
+			if strings.Contains(lines[i], prefix) {
+				v.headings = append(v.headings, h)
+				v.headingLines = append(v.headingLines, i)
+				next = i + 1
+				break
+			}
+		}
+	}
+	if v.selectedHeading >= len(v.headings) {
+		v.selectedHeading = 0
+	}
+}
+
+// showingLoops returns true if the growth plan tab is rendering parsed loops
+func (v *ResultsView) showingLoops() bool {
+	return v.tabs[v.activeTab] == constants.TabGrowthPlan && len(v.loops) > 0 && !v.showRawPlan
+}
+
 // HandleTab cycles focus
 func (v *ResultsView) HandleTab() {
 	if v.focus == ResultsFocusTabs {
@@ -137,12 +377,105 @@ func (v *ResultsView) HandleTab() {
 }
 
 func (v *ResultsView) updateContent() {
-	tabName := v.tabs[v.activeTab]
-	if content, ok := v.contents[tabName]; ok {
-		wrapped := lipgloss.NewStyle().Width(v.viewport.Width).Render(content)
-		v.viewport.SetContent(wrapped)
+	if v.showingLoops() {
+		v.headings, v.headingLines = nil, nil
+		v.renderLoopContent()
+		v.viewport.GotoTop()
+	} else if content, ok := v.contents[v.tabs[v.activeTab]]; ok {
+		rendered := v.renderContent(content)
+		v.viewport.SetContent(rendered)
+		v.indexHeadings(content, rendered)
 		v.viewport.GotoTop()
 	}
+
+	// Close the contents panel on a tab without headings
+	if v.showTOC && len(v.headings) == 0 {
+		v.showTOC = false
+		v.resizeViewport()
+	}
+}
+
+// renderContent styles a tab for the viewport. Markdown is rendered with
+// glamour in the terminal's light or dark style and JSON is shown as a
+// highlighted code block. Falls back to plain wrapped text on any error.
+func (v *ResultsView) renderContent(content string) string {
+	width := v.viewport.Width
+	plain := lipgloss.NewStyle().Width(width).Render(content)
+
+	if v.renderer == nil || v.rendererWidth != width {
+		style := "dark"
+		if !styles.IsDarkBackground {
+			style = "light"
+		}
+		r, err := glamour.NewTermRenderer(
+			glamour.WithStandardStyle(style),
+			glamour.WithWordWrap(width-4),
+		)
+		if err != nil {
+			return plain
+		}
+		v.renderer = r
+		v.rendererWidth = width
+	}
+
+	md := content
+	if trimmed := strings.TrimSpace(content); json.Valid([]byte(trimmed)) {
+		md = "```json\n" + trimmed + "\n```"
+	}
+	out, err := v.renderer.Render(md)
+	if err != nil {
+		return plain
+	}
+	// Code blocks are not wrapped by glamour, so clamp to the viewport
+	return lipgloss.NewStyle().Width(width).Render(strings.Trim(out, "\n"))
+}
+
+// renderLoopContent renders the growth loops as a list with the selected
+// loop expanded, keeping the selection inside the viewport.
+func (v *ResultsView) renderLoopContent() {
+	width := v.viewport.Width
+	var lines []string
+	selectedStart := 0
+
+	for i, loop := range v.loops {
+		title := fmt.Sprintf("Loop %d: %s", loop.Number, loop.Name)
+		if i != v.selectedLoop {
+			meta := ""
+			if loop.Priority != "" {
+				meta = styles.Muted.Render("  [" + loop.Priority + "]")
+			}
+			lines = append(lines, styles.ListItem.Render(title)+meta)
+			continue
+		}
+
+		selectedStart = len(lines)
+		lines = append(lines, styles.ListItemSelected.Render(title))
+		detail := lipgloss.NewStyle().PaddingLeft(4).Width(width)
+		if loop.Priority != "" {
+			lines = append(lines, detail.Render(styles.Label.Render("Priority: ")+styles.Value.Render(loop.Priority)))
+		}
+		if loop.Impact != "" {
+			lines = append(lines, detail.Render(styles.Label.Render("Impact:   ")+styles.Value.Render(loop.Impact)))
+		}
+		for _, action := range loop.Actions {
+			lines = append(lines, detail.Foreground(styles.Sand).Render("• "+action))
+		}
+		lines = append(lines, "")
+	}
+
+	content := lipgloss.JoinVertical(lipgloss.Left, lines...)
+	v.viewport.SetContent(content)
+
+	// Keep the expanded loop visible
+	startLine := 0
+	if selectedStart > 0 {
+		startLine = lipgloss.Height(lipgloss.JoinVertical(lipgloss.Left, lines[:selectedStart]...))
+	}
+	if startLine < v.viewport.YOffset {
+		v.viewport.SetYOffset(startLine)
+	} else if startLine >= v.viewport.YOffset+v.viewport.Height {
+		v.viewport.SetYOffset(startLine - v.viewport.Height + 1)
+	}
 }
 
 // Render the results view
@@ -161,6 +494,13 @@ func (v *ResultsView) Render() string {
 	// Success banner
 	banner := styles.SuccessText.Render(constants.ResultsBanner)
 
+	// At-a-glance summary
+	summary := v.renderSummary()
+	if v.dashboardNote != "" {
+		summary = lipgloss.JoinVertical(lipgloss.Left, summary,
+			lipgloss.NewStyle().Foreground(styles.Warning).Render(v.dashboardNote))
+	}
+
 	// Tabs
 	tabsView := v.renderTabs()
 
@@ -171,7 +511,7 @@ func (v *ResultsView) Render() string {
 	footer := lipgloss.NewStyle().
 		Width(v.width).
 		Align(lipgloss.Center).
-		Render(components.WizardResultsHelp())
+		Render(v.footerHelp())
 
 	// Combine
 	content := lipgloss.JoinVertical(
@@ -179,6 +519,7 @@ func (v *ResultsView) Render() string {
 		wizHeader,
 		"",
 		banner,
+		summary,
 		"",
 		tabsView,
 		contentBox,
@@ -195,6 +536,31 @@ func (v *ResultsView) Render() string {
 	return mainContent + "\n" + footer
 }
 
+// renderSummary shows the loop count, high-priority opportunities and the
+// detected primary language and framework on one line
+func (v *ResultsView) renderSummary() string {
+	parts := []string{fmt.Sprintf(constants.ResultsLoops, len(v.loops))}
+
+	if v.generated[constants.TabGrowthManifest] {
+		summary := growth.SummarizeManifest(v.contents[constants.TabGrowthManifest])
+		parts = append(parts, fmt.Sprintf(constants.ResultsHighPrio, summary.HighPriority))
+		if stack := summary.TechStack; !stack.IsEmpty() {
+			var primary []string
+			if len(stack.Languages) > 0 {
+				primary = append(primary, stack.Languages[0])
+			}
+			if len(stack.Frameworks) > 0 {
+				primary = append(primary, stack.Frameworks[0])
+			}
+			if len(primary) > 0 {
+				parts = append(parts, fmt.Sprintf(constants.ResultsStack, strings.Join(primary, " / ")))
+			}
+		}
+	}
+
+	return styles.Accent.Render(strings.Join(parts, "  ·  "))
+}
+
 func (v *ResultsView) renderTabs() string {
 	var tabs []string
 	for i, tab := range v.tabs {
@@ -221,11 +587,87 @@ func (v *ResultsView) renderContentBox() string {
 		Padding(1, 2).
 		Width(v.viewport.Width + 6)
 
-	return boxStyle.Render(v.viewport.View())
+	content := boxStyle.Render(v.viewport.View())
+	if !v.showTOC {
+		return content
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Top, v.renderTOC(lipgloss.Height(content)), " ", content)
+}
+
+// renderTOC draws the contents panel at the given height, scrolled to keep
+// the selected heading visible
+func (v *ResultsView) renderTOC(height int) string {
+	// Border and padding take two lines each and the title one more
+	visible := height - 5
+	if visible < 1 {
+		visible = 1
+	}
+	start := 0
+	if v.selectedHeading >= visible {
+		start = v.selectedHeading - visible + 1
+	}
+	end := start + visible
+	if end > len(v.headings) {
+		end = len(v.headings)
+	}
+
+	lines := []string{styles.Label.Render(constants.ResultsTOCTitle)}
+	itemWidth := constants.ResultsTOCWidth - 4
+	for i := start; i < end; i++ {
+		h := v.headings[i]
+		indent := strings.Repeat(" ", 2*min(h.Level-1, 3))
+		title := ansi.Truncate(indent+h.Title, itemWidth-2, "…")
+		if i == v.selectedHeading {
+			lines = append(lines, styles.ListItemSelected.Render(title))
+		} else {
+			lines = append(lines, styles.ListItemDimmed.Render(title))
+		}
+	}
+	return lipgloss.NewStyle().
+		Border(lipgloss.NormalBorder()).
+		BorderForeground(styles.MidGray).
+		Padding(1, 1).
+		Width(constants.ResultsTOCWidth).
+		Height(height - 2).
+		Render(strings.Join(lines, "\n"))
+}
+
+// footerHelp is the results footer, with the dashboard key when one is offered
+func (v *ResultsView) footerHelp() string {
+	if v.dashboardURL == "" {
+		return components.WizardResultsHelp()
+	}
+	return components.WizardResultsHelp(components.HelpItem{Key: constants.HelpKeyD, Desc: constants.HelpDescDashboard})
 }
 
 // GetHelpItems returns context-specific help
 func (v *ResultsView) GetHelpItems() []components.HelpItem {
+	items := v.helpItems()
+	if v.dashboardURL == "" {
+		return items
+	}
+	// Offer the dashboard just before quit
+	last := len(items) - 1
+	return append(items[:last:last],
+		components.HelpItem{Key: constants.HelpKeyD, Desc: constants.HelpDescDashboard},
+		items[last],
+	)
+}
+
+func (v *ResultsView) helpItems() []components.HelpItem {
+	items := v.viewHelpItems()
+	if !v.CanRegenerate() {
+		return items
+	}
+	// Offer regenerating just before next steps
+	last := len(items) - 2
+	return append(items[:last:last],
+		components.HelpItem{Key: constants.HelpKeyP, Desc: constants.HelpDescRegenerate},
+		items[last], items[last+1],
+	)
+}
+
+func (v *ResultsView) viewHelpItems() []components.HelpItem {
 	if v.focus == ResultsFocusTabs {
 		return []components.HelpItem{
 			{Key: constants.HelpKeyLeftRight, Desc: constants.HelpDescSwitchTabs},
@@ -234,28 +676,140 @@ func (v *ResultsView) GetHelpItems() []components.HelpItem {
 			{Key: constants.HelpKeyCtrlC, Desc: constants.HelpDescQuit},
 		}
 	}
-	return []components.HelpItem{
+	if v.showTOC {
+		return []components.HelpItem{
+			{Key: constants.HelpKeyUpDown, Desc: constants.HelpDescJumpSection},
+			{Key: constants.HelpKeyT, Desc: constants.HelpDescCloseContents},
+			{Key: constants.HelpKeyTab, Desc: constants.HelpDescFocusTabs},
+			{Key: constants.HelpKeyN, Desc: constants.HelpDescNextSteps},
+			{Key: constants.HelpKeyCtrlC, Desc: constants.HelpDescQuit},
+		}
+	}
+	if v.showingLoops() {
+		return []components.HelpItem{
+			{Key: constants.HelpKeyUpDown, Desc: constants.HelpDescSelectLoop},
+			{Key: constants.HelpKeyR, Desc: constants.HelpDescRawPlan},
+			{Key: constants.HelpKeyTab, Desc: constants.HelpDescFocusTabs},
+			{Key: constants.HelpKeyN, Desc: constants.HelpDescNextSteps},
+			{Key: constants.HelpKeyCtrlC, Desc: constants.HelpDescQuit},
+		}
+	}
+	items := []components.HelpItem{
 		{Key: constants.HelpKeyUpDown, Desc: constants.HelpDescScroll},
+		{Key: constants.HelpKeyPgUpDown, Desc: constants.HelpDescPage},
+		{Key: constants.HelpKeyHomeEnd, Desc: constants.HelpDescTopBottom},
 		{Key: constants.HelpKeyTab, Desc: constants.HelpDescFocusTabs},
-		{Key: constants.HelpKeyN, Desc: constants.HelpDescNextSteps},
-		{Key: constants.HelpKeyCtrlC, Desc: constants.HelpDescQuit},
 	}
+	if len(v.headings) > 0 {
+		items = append(items, components.HelpItem{Key: constants.HelpKeyT, Desc: constants.HelpDescContents})
+	}
+	return append(items,
+		components.HelpItem{Key: constants.HelpKeyN, Desc: constants.HelpDescNextSteps},
+		components.HelpItem{Key: constants.HelpKeyCtrlC, Desc: constants.HelpDescQuit},
+	)
+}
+
+// ActiveSection returns the output section shown in the current tab
+func (v *ResultsView) ActiveSection() growth.Section {
+	switch v.tabs[v.activeTab] {
+	case constants.TabGrowthManifest:
+		return growth.SectionManifest
+	case constants.TabGrowthTemplate:
+		return growth.SectionTemplate
+	default:
+		return growth.SectionPlan
+	}
+}
+
+// SetDashboard offers the Skene dashboard at url; empty hides it
+func (v *ResultsView) SetDashboard(url string) {
+	v.dashboardURL = url
 }
 
-// RefreshContent reloads file content from the given directory
-func (v *ResultsView) RefreshContent(outputDir string) {
-	manifest := loadResultFile(outputDir, constants.GrowthManifestFile)
+// DashboardURL returns the dashboard offered on this screen, if any
+func (v *ResultsView) DashboardURL() string {
+	return v.dashboardURL
+}
+
+// SetDashboardFailed shows the dashboard URL for copying by hand after the
+// browser could not be opened
+func (v *ResultsView) SetDashboardFailed() {
+	v.dashboardNote = fmt.Sprintf(constants.ResultsDashboard, v.dashboardURL)
+}
+
+// SetChanges adds a "Changes" tab summarising the difference from the
+// previous run. An empty summary leaves the tab hidden.
+func (v *ResultsView) SetChanges(summary string) {
+	if summary == "" {
+		return
+	}
+	v.contents[constants.TabChanges] = summary
+	v.generated[constants.TabChanges] = true
+	v.rebuildTabs()
+	v.updateContent()
+}
+
+// CanRegenerate reports whether the active tab is backed by a section that
+// can be rerun on its own. Only the growth plan has its own subcommand.
+func (v *ResultsView) CanRegenerate() bool {
+	return v.tabs[v.activeTab] == constants.TabGrowthPlan
+}
+
+// ActiveTabName returns the label of the current tab
+func (v *ResultsView) ActiveTabName() string {
+	return v.tabs[v.activeTab]
+}
+
+// SetSectionContent replaces the content of a single tab after it was
+// regenerated, leaving the others untouched
+func (v *ResultsView) SetSectionContent(section growth.Section, content string) {
+	if content == "" {
+		return
+	}
+	var tab string
+	switch section {
+	case growth.SectionManifest:
+		tab = constants.TabGrowthManifest
+	case growth.SectionTemplate:
+		tab = constants.TabGrowthTemplate
+	case growth.SectionPlan:
+		tab = constants.TabGrowthPlan
+		v.loops = growth.ParseGrowthLoops(content)
+		if v.selectedLoop >= len(v.loops) {
+			v.selectedLoop = 0
+		}
+	default:
+		return
+	}
+	v.contents[tab] = content
+	v.generated[tab] = true
+	v.rebuildTabs()
+	v.updateContent()
+}
+
+// RefreshContent reloads file content from the given directory, looking
+// the files up under the configured names
+func (v *ResultsView) RefreshContent(outputDir string, names growth.OutputNames) {
+	manifest := loadResultFile(outputDir, names.File(constants.ArtifactGrowthManifest))
 	if manifest != "" {
 		v.contents[constants.TabGrowthManifest] = manifest
+		v.generated[constants.TabGrowthManifest] = true
 	}
-	template := loadResultFile(outputDir, constants.GrowthTemplateFile)
+	template := loadResultFile(outputDir, names.File(constants.ArtifactGrowthTemplate))
 	if template != "" {
 		v.contents[constants.TabGrowthTemplate] = template
+		v.generated[constants.TabGrowthTemplate] = true
 	}
-	plan := loadResultFile(outputDir, constants.GrowthPlanFile)
+	plan := loadResultFile(outputDir, names.File(constants.ArtifactGrowthPlan))
 	if plan != "" {
 		v.contents[constants.TabGrowthPlan] = plan
+		v.generated[constants.TabGrowthPlan] = true
+		v.loops = growth.ParseGrowthLoops(plan)
+		if v.selectedLoop >= len(v.loops) {
+			v.selectedLoop = 0
+		}
 	}
+	v.rebuildTabs()
 	v.updateContent()
 }
 
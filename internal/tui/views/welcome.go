@@ -1,6 +1,8 @@
 package views
 
 import (
+	"fmt"
+
 	tea "github.com/charmbracelet/bubbletea"
 	"skene/internal/constants"
 	"skene/internal/tui/components"
@@ -15,6 +17,10 @@ type WelcomeView struct {
 	height int
 	time   float64
 	anim   components.ASCIIMotionModel
+	static bool // show the first frame only and schedule no frames
+
+	updateVersion string // newer release tag, empty when up to date
+	savedConfig   string // "Provider / Model" when a usable config is saved
 }
 
 // NewWelcomeView creates a new welcome view
@@ -36,8 +42,27 @@ func (v *WelcomeView) SetTime(t float64) {
 	v.time = t
 }
 
+// SetUpdateAvailable records a newer release to hint at on the welcome screen
+func (v *WelcomeView) SetUpdateAvailable(version string) {
+	v.updateVersion = version
+}
+
+// SetStatic turns the logo animation off (or back on)
+func (v *WelcomeView) SetStatic(static bool) {
+	v.static = static
+}
+
+// SetSavedConfig offers the saved provider and model as a shortcut; an
+// empty label hides it
+func (v *WelcomeView) SetSavedConfig(label string) {
+	v.savedConfig = label
+}
+
 // UpdateAnimation updates the animation model with a message
 func (v *WelcomeView) UpdateAnimation(msg tea.Msg) tea.Cmd {
+	if v.static {
+		return nil
+	}
 	var cmd tea.Cmd
 	updatedModel, cmd := v.anim.Update(msg)
 	v.anim = updatedModel.(components.ASCIIMotionModel)
@@ -46,6 +71,9 @@ func (v *WelcomeView) UpdateAnimation(msg tea.Msg) tea.Cmd {
 
 // InitAnimation returns the initialization command for the animation
 func (v *WelcomeView) InitAnimation() tea.Cmd {
+	if v.static {
+		return nil
+	}
 	return v.anim.Init()
 }
 
@@ -53,6 +81,9 @@ func (v *WelcomeView) InitAnimation() tea.Cmd {
 func (v *WelcomeView) ResetAnimation() tea.Cmd {
 	v.anim = components.NewASCIIMotion(styles.IsDarkBackground)
 	v.anim.SetSize(v.width, v.height)
+	if v.static {
+		return nil
+	}
 	return v.anim.Init()
 }
 
@@ -75,19 +106,18 @@ func (v *WelcomeView) Render() string {
 	// Call to action
 	enterKey := styles.Accent.Bold(true).Render(constants.WelcomeCTA)
 	cta := center.Render(enterKey)
+	if v.savedConfig != "" {
+		cta += "\n" + center.Render(styles.Muted.Render(fmt.Sprintf(constants.WelcomeSaved, v.savedConfig)))
+	}
 
-	// Version info
-	version := center.Render(styles.Muted.Render(constants.Version + " • " + constants.Repository))
+	// Repository info
+	repo := center.Render(styles.Muted.Render(constants.Repository))
 
 	// Footer help
-	footer := components.FooterHelp([]components.HelpItem{
-		{Key: constants.HelpKeyEnter, Desc: constants.HelpDescStart},
-		{Key: constants.HelpKeyCtrlC, Desc: constants.HelpDescQuit},
-	})
+	footer := components.FooterHelp(v.GetHelpItems())
 
 	// Combine elements
-	content := lipgloss.JoinVertical(
-		lipgloss.Center,
+	parts := []string{
 		logo,
 		"",
 		"",
@@ -95,15 +125,27 @@ func (v *WelcomeView) Render() string {
 		"",
 		subtitle,
 		"",
-		version,
-	)
+		repo,
+	}
+	if v.updateVersion != "" {
+		update := fmt.Sprintf(constants.WelcomeUpdate, v.updateVersion)
+		parts = append(parts, center.Render(lipgloss.NewStyle().Foreground(styles.Warning).Render(update)))
+	}
+	content := lipgloss.JoinVertical(lipgloss.Center, parts...)
+
+	// Version pinned to the top-right corner
+	corner := lipgloss.NewStyle().
+		Width(v.width).
+		Align(lipgloss.Right).
+		PaddingRight(1).
+		Render(styles.Muted.Render(constants.Version))
 
 	centered := lipgloss.Place(
 		v.width,
-		v.height-3,
+		v.height-4,
 		lipgloss.Center,
 		lipgloss.Top,
-		lipgloss.NewStyle().PaddingTop(2).Render(content),
+		lipgloss.NewStyle().PaddingTop(1).Render(content),
 	)
 
 	// Footer pinned at bottom
@@ -113,13 +155,17 @@ func (v *WelcomeView) Render() string {
 		MarginTop(1).
 		Render(footer)
 
-	return centered + "\n" + footerStyled
+	return corner + "\n" + centered + "\n" + footerStyled
 }
 
 // GetHelpItems returns context-specific help
 func (v *WelcomeView) GetHelpItems() []components.HelpItem {
-	return []components.HelpItem{
+	items := []components.HelpItem{
 		{Key: constants.HelpKeyEnter, Desc: constants.HelpDescStart},
-		{Key: constants.HelpKeyCtrlC, Desc: constants.HelpDescQuit},
 	}
+	if v.savedConfig != "" {
+		items = append(items, components.HelpItem{Key: constants.HelpKeyS, Desc: constants.HelpDescUseSaved})
+	}
+	items = append(items, components.HelpItem{Key: constants.HelpKeyR, Desc: constants.HelpDescHistory})
+	return append(items, components.HelpItem{Key: constants.HelpKeyCtrlC, Desc: constants.HelpDescQuit})
 }